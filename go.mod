@@ -4,6 +4,9 @@ go 1.25.0
 
 require (
 	github.com/awesome-gocui/gocui v1.1.0
+	github.com/fsnotify/fsnotify v1.10.1
+	github.com/klauspost/compress v1.20.1
+	github.com/ulikunitz/xz v0.5.17
 	golang.org/x/text v0.35.0
 	gopkg.in/yaml.v3 v3.0.1
 )
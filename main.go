@@ -3,6 +3,9 @@ package main
 import (
 	"bufio"
 	"bytes"
+	"cmp"
+	"compress/bzip2"
+	"compress/gzip"
 	"context"
 	"encoding/json"
 	"errors"
@@ -11,10 +14,12 @@ import (
 	"io"
 	"log"
 	"log/slog"
+	"maps"
 	"math"
 	"os"
 	"os/exec"
 	"os/user"
+	"path"
 	"path/filepath"
 	"regexp"
 	"runtime"
@@ -28,6 +33,9 @@ import (
 	"unicode/utf8"
 
 	"github.com/awesome-gocui/gocui"
+	"github.com/fsnotify/fsnotify"
+	"github.com/klauspost/compress/zstd"
+	"github.com/ulikunitz/xz"
 	"golang.org/x/text/encoding/charmap"
 	winUnicode "golang.org/x/text/encoding/unicode"
 	"gopkg.in/yaml.v3"
@@ -64,10 +72,32 @@ type Settings struct {
 	JournalField        string `yaml:"journalField"`
 	JournalPriority     string `yaml:"journalPriority"`
 	JournalBoot         string `yaml:"journalBoot"`
+	JournalNamespace    string `yaml:"journalNamespace"`
 	CustomPath          string `yaml:"customPath"`
 	ColorMode           string `yaml:"colorMode"`
 	ColorActionsDisable string `yaml:"colorActionsDisable"`
 	DisableFastMode     string `yaml:"disableFastMode"`
+	FollowMode          string `yaml:"followMode"`
+	JournalJson         string `yaml:"journalJson"`
+	JournalPath         string `yaml:"journalPath"`
+
+	// Сохраненные условия journald для списка системных журналов
+	JournalMatches []string `yaml:"journalMatches"`
+
+	// Путь к журналу аудита для чтения событий auditd
+	AuditLogPath string `yaml:"auditLogPath"`
+
+	// Именованные коллекции файлов журналов, каждая выводится отдельным списком в окне файловой системы
+	LogCollections []LogCollection `yaml:"logCollections"`
+}
+
+// Структура коллекции файлов журналов по шаблонам путей (** соответствует любому количеству каталогов)
+type LogCollection struct {
+	Name           string   `yaml:"name"`
+	Include        []string `yaml:"include"`
+	Exclude        []string `yaml:"exclude"`        // шаблон без "/" сравнивается с именем файла
+	MaxDepth       int      `yaml:"maxDepth"`       // глубина поиска от каталога шаблона (0 - без ограничения)
+	FollowSymlinks bool     `yaml:"followSymlinks"` // переход по символическим ссылкам на файлы и каталоги
 }
 
 // Структура доступных параметров для настройки интерфейса (#37)
@@ -118,9 +148,18 @@ type Hotkeys struct {
 	UpdateLists          string `yaml:"updateLists"`
 	SwitchColorMode      string `yaml:"switchColorMode"`
 	SwitchPriority       string `yaml:"switchPriority"`
+	SwitchNamespace      string `yaml:"switchNamespace"`
 	SwitchDockerMode     string `yaml:"switchDockerMode"`
 	SwitchStreamMode     string `yaml:"switchStreamMode"`
 	TimestampShow        string `yaml:"timestampShow"`
+	SwitchFollowMode     string `yaml:"switchFollowMode"`
+	SwitchJournalJson    string `yaml:"switchJournalJson"`
+	ShowFields           string `yaml:"showFields"`
+	MarkJournal          string `yaml:"markJournal"`
+	ShowUnit             string `yaml:"showUnit"`
+	MatchBuilder         string `yaml:"matchBuilder"`
+	SwitchFamilyView     string `yaml:"switchFamilyView"`
+	SortFileList         string `yaml:"sortFileList"`
 	Exit                 string `yaml:"exit"`
 }
 
@@ -136,8 +175,11 @@ type Journal struct {
 }
 
 type Logfile struct {
-	name string
-	path string
+	name    string
+	path    string
+	family  []string  // ротированные файлы семейства от старых к новым (в режиме объединения семейств)
+	size    int64     // размер файла для сортировки списка
+	modTime time.Time // время последнего изменения файла для сортировки списка
 }
 
 type DockerContainers struct {
@@ -147,13 +189,6 @@ type DockerContainers struct {
 	namespace string
 }
 
-// Структура для парсинга логов из docker cli
-type dockerLogLines struct {
-	isError   bool
-	timestamp time.Time
-	content   string
-}
-
 // Основная структура приложения (графический интерфейс и данные журналов)
 type App struct {
 	gui *gocui.Gui // графический интерфейс (gocui)
@@ -173,18 +208,16 @@ type App struct {
 	fileSystemFrameColor  gocui.Attribute
 	dockerFrameColor      gocui.Attribute
 
-	sshMode                bool     // использовать вызов команд (exec.Command) через ssh
-	sshStatus              string   // режим работы (false или имя хоста) для статуса
-	sshOptions             []string // опции для ssh подключения
-	fastMode               bool     // загрузка журналов в горутине (beta mode)
-	testMode               bool     // исключаем вызовы к gocui при тестирование функций
-	colorMode              string   // режим покраски (default/tailspin/bat/disable)
-	colorActionsDisable    bool     // отключить покраску для действий
-	mouseSupport           bool     // включение/отключение поддержки мыши
-	wrapSupport            bool     // включение/отключение встроенного переноса строк в окне содержимого логов
-	dockerStreamLogs       bool     // принудительное чтение журналов контейнеров Docker из потоков (по умолчанию, чтение происходит из файловой системы, если есть доступ)
-	dockerStreamLogsStatus string   // отображаемый режим чтения журнала Docker в статусе (в зависимости от прав доступа и флага)
-	dockerStreamMode       string   // переменная для хранения режима чтения потоков (stream, stdout или stderr)
+	sshMode             bool     // использовать вызов команд через ssh (sshExecutor)
+	sshStatus           string   // режим работы (false или имя хоста) для статуса
+	sshOptions          []string // опции для ssh подключения
+	fastMode            bool     // загрузка журналов в горутине (beta mode)
+	testMode            bool     // исключаем вызовы к gocui при тестирование функций
+	colorMode           string   // режим покраски (default/tailspin/bat/disable)
+	colorActionsDisable bool     // отключить покраску для действий
+	mouseSupport        bool     // включение/отключение поддержки мыши
+	wrapSupport         bool     // включение/отключение встроенного переноса строк в окне содержимого логов
+	dockerStreamMode    string   // переменная для хранения режима чтения потоков (stream, stdout или stderr)
 
 	dockerContext             string
 	podmanContext             string
@@ -199,14 +232,20 @@ type App struct {
 	systemDisk    string   // порядковая буква системного диска для Windows
 	userNameArray []string // список всех пользователей
 
-	unitType        string // фильтрация списков системных и пользовательских юнитов по типу (#46)
-	journalField    string // фильтрация списка системных журналов по полю
-	journalPriority string // фильтрация вывода системных и пользовательских журналов по приоритету
-	journalBoot     string // фильтрация вывода системных и пользовательских журналов по порядковому номеру загрузки системы
-	customPath      string // пользовательский путь для поиска логов в файловой системе (#31)
+	unitType         string // фильтрация списков системных и пользовательских юнитов по типу (#46)
+	journalField     string // фильтрация списка системных журналов по полю
+	journalPriority  string // фильтрация вывода системных и пользовательских журналов по приоритету
+	journalBoot      string // фильтрация вывода системных и пользовательских журналов по порядковому номеру загрузки системы
+	journalNamespace string // пространство имен journald для списков юнитов и журналов (journalctl --namespace)
+	customPath       string // пользовательский путь для поиска логов в файловой системе (#31)
+	auditLogPath     string // путь к журналу аудита (log_file в auditd.conf)
+
+	logCollections []LogCollection // именованные коллекции файлов журналов из конфигурации (списки collection:name)
 
-	selectUnits                  string // название журнала (systemUnits/userUnits/systemJournals/kernelBoot/auditd)
-	selectPath                   string // путь к логам (varlog/customPath/home/descriptor)
+	auditCache *auditCache // разобранные события журнала аудита (общие для копий приложения)
+
+	selectUnits                  string // название журнала (systemUnits/userUnits/systemJournals/kernelBoot/auditd/coredumps)
+	selectPath                   string // путь к логам (varlog/customPath/home/descriptor или collection:name)
 	selectContainerizationSystem string // название системы контейнеризации (docker/compose/podman/kubernetes)
 	selectFilterMode             string // режим фильтрации (default/fuzzy/regex/timestamp)
 
@@ -218,11 +257,20 @@ type App struct {
 	maxVisibleServices int       // максимальное количество видимых элементов в окне списка служб
 	startServices      int       // индекс первого видимого элемента
 	selectedJournal    int       // индекс выбранного журнала
+	markedJournals     []string  // журналы, отмеченные в списке для объединенного вывода
+	unitDetail         bool      // отображение панели с информацией о юните под курсором
+	unitDetailSeq      int       // номер последнего запроса информации о юните (устаревшие результаты не выводятся)
 
 	logfiles        []Logfile
 	maxVisibleFiles int
 	startFiles      int
 	selectedFile    int
+	familyView      bool   // объединение ротированных файлов (syslog, syslog.1, syslog.2.gz) в одну запись списка
+	fileSortMode    string // сортировка списка файлов (mtime/name/size)
+
+	fileListWatch      context.CancelFunc // остановка наблюдения за каталогами текущего списка файлов
+	fileListRefresh    bool               // список файлов загружается для обновления на месте (в копии приложения)
+	fileListRefreshing bool               // обновление списка файлов на месте еще выполняется
 
 	dockerContainers           []DockerContainers
 	maxVisibleDockerContainers int
@@ -253,12 +301,15 @@ type App struct {
 	windowWidth  int
 	windowHeight int
 
-	minSymbolFilter  int      // минимальное кол-во символов дли фильтрации вывода
-	filterText       string   // текст для фильтрации записей журнала
-	currentLogLines  []string // набор строк (срез) для хранения журнала без фильтрации
-	filteredLogLines []string // набор строк (срез) для хранения журнала после фильтра
-	logScrollPos     int      // позиция прокрутки для отображаемых строк журнала
-	lastFilterText   string   // фиксируем содержимое последнего ввода текста для фильтрации
+	minSymbolFilter    int         // минимальное кол-во символов дли фильтрации вывода
+	filterText         string      // текст для фильтрации записей журнала
+	currentLogRecords  []LogRecord // набор записей (срез) для хранения журнала без фильтрации
+	filteredLogLines   []string    // набор строк (срез) для хранения журнала после фильтра
+	filteredLogRecords []LogRecord // записи журнала для строк после фильтра (для просмотра полей записи)
+	logScrollPos       int         // позиция прокрутки для отображаемых строк журнала
+	logViewStart       int         // индекс первой выведенной строки журнала в окне
+	lastFilterText     string      // фиксируем содержимое последнего ввода текста для фильтрации
+	journalGrepTimer   *time.Timer // задержка перезагрузки журнала при вводе фильтра regex
 
 	// Настройка логирования приложения
 	logging     bool
@@ -271,22 +322,33 @@ type App struct {
 	lastUpdateLine    string // фиксируем предпоследнюю строку для делимитра
 	updateTime        string // фиксируем время загрузки журнала для делимитра
 
-	lastDateUpdateFile time.Time // последняя дата изменения файла
-	lastSizeFile       int64     // размер файла
-	updateFile         bool      // проверка для обновления вывода в горутине (отключение только если нет изменений в файле и для Windows Event)
-
-	lastWindow   string // фиксируем последний используемый источник для вывода логов
-	lastSelected string // фиксируем название последнего выбранного журнала или контейнера
-
-	// Переменные для хранения значений автообновления вывода при смене окна
-	lastSelectUnits            string
-	lastBootId                 string
-	lastLogPath                string
-	lastContainerizationSystem string
-	lastContainerId            string
+	currentSource LogSource    // фиксируем последний используемый источник для вывода логов
+	lastSelected  string       // фиксируем название последнего выбранного журнала или контейнера
+	followMode    bool         // чтение новых строк журнала из постоянного потока вместо обновления по таймеру
+	follower      *logFollower // активный поток чтения новых строк в режиме follow
+	journalJson   bool         // чтение журналов journald в формате JSON со всеми полями записи
+	fieldsRecords []LogRecord  // записи журнала, доступные для просмотра полей
+	fieldsIndex   int          // индекс записи для просмотра полей
+	journalPath   string       // каталог или файл журнала для чтения offline (journalctl --directory/--file)
+
+	journalMatches []string // сохраненные условия journald для списка системных журналов
+	matchTerms     []string // условия, собранные в построителе (FIELD=value или + для дизъюнкции)
+	matchField     string   // поле, для которого выбирается значение в построителе
+	matchOptions   []string // список полей или значений в окне построителя
+	matchFields    []string // список полей, загруженный при открытии построителя
+	matchLoading   bool     // загрузка списка полей или значений в горутине
+	matchSession   int      // номер открытия построителя (результаты загрузки после закрытия не выводятся)
+
+	loadCancel context.CancelFunc // отмена незавершенной загрузки журнала при выборе другого журнала или обновлении
+	loadDone   chan struct{}      // закрывается по завершению текущей загрузки журнала в fastMode
+	loadApply  func()             // применение результата загрузки без интерфейса, которое выполняет waitLoad (testMode)
+	background bool               // копия приложения для загрузки в горутине (не обращается к интерфейсу)
+	pending    []func(app *App)   // отложенные действия с интерфейсом из горутины, которые выполняются в основном потоке
+
+	// Состояние, которое изменяет загрузка журнала (источники журналов и отслеживание изменений для автообновления)
+	loadState
 
 	// Фиксируем последнее время загрузки и покраски журнала
-	debugStartTime time.Time
 	debugLoadTime  string
 	debugColorTime string
 
@@ -310,8 +372,9 @@ type App struct {
 	backCurrentView   bool   // отключаем/ключаем возврат
 	globalCurrentView string // хранение названия текущего окна после возврата из окна менеджера
 
-	dockerCompose        string            // название используемого исполняемого файла docker-compose или как плагин "docker compose"
-	uniquePrefixColorMap map[string]string // карта для хранения уникального цвета для каждого контейнера в стеках compose
+	executor Executor // исполнитель команд (по умолчанию определяется режимом работы, подменяется в тестах)
+
+	dockerCompose string // название используемого исполняемого файла docker-compose или как плагин "docker compose"
 }
 
 // Описание флагов
@@ -322,6 +385,9 @@ var (
 	auditDescription             = "Show audit information"
 	loggingDescription           = "Enable logging of executed commands for debugging"
 	tailModeDisableDescription   = "Disable streaming of new events (log is loaded once without update)"
+	followModeDescription        = "Read new events from a persistent stream (journalctl -f, docker/kubectl logs -f) instead of periodic reload"
+	journalJsonDescription       = "Read journald logs in JSON format to view all fields of the entry (journalctl -o json)"
+	journalPathDescription       = "Read an offline journal from a directory or .journal file instead of the live system (journalctl --directory/--file)"
 	tailLinesDescription         = "Change the number of log lines to output (range: 200-200000, default: 10K)"
 	updateIntervalDescription    = "Change the update interval of the log output (range: 2-10, default: 5)"
 	minSymbolsFilterDescription  = "Minimum number of symbols for filtering output (range: 1-10, default: 3)"
@@ -337,6 +403,7 @@ var (
 	journalFieldDescription      = "Filter the list of system journals by field, e.g. _UID/_PID/_COMM/_EXE/_CMDLINE (default: SYSLOG_IDENTIFIER)"
 	journalPriorityDescription   = "Filter the log output by priority (available values: debug, info, notice, warning, err, crit, alert, emerg)"
 	journalBootDescription       = "Filter the log output by system boot period, e.g. 0 current or -1 previous (default: all)"
+	journalNamespaceDescription  = "Read units and journals from the specified journald namespace, \"*\" for all or \"+name\" with the default (journalctl --namespace)"
 	pathDescription              = "Custom the path in the file system to search for logs (\"/opt\" in Linux and \"$HOME/Documents\" in Windows by default)"
 	colorModeDescription         = "Highlighting mode for logs (available values: default, tailspin, bat or disable)"
 	commandColorDescription      = "ANSI coloring in command line mode"
@@ -359,6 +426,7 @@ func showHelp() {
 	fmt.Println("    --audit, -a                " + auditDescription)
 	fmt.Println("    --logging, -l              " + loggingDescription)
 	fmt.Println("    --tail-mode-disable, -d    " + tailModeDisableDescription)
+	fmt.Println("    --follow-mode, -e          " + followModeDescription)
 	fmt.Println("    --tail-lines, -t           " + tailLinesDescription)
 	fmt.Println("    --update-interval, -u      " + updateIntervalDescription)
 	fmt.Println("    --min-symbols-filter, -F   " + minSymbolsFilterDescription)
@@ -370,6 +438,9 @@ func showHelp() {
 	fmt.Println("    --journal-field, -j        " + journalFieldDescription)
 	fmt.Println("    --journal-priority, -J     " + journalPriorityDescription)
 	fmt.Println("    --journal-boot, -b         " + journalBootDescription)
+	fmt.Println("    --journal-namespace, -N    " + journalNamespaceDescription)
+	fmt.Println("    --journal-json, -O         " + journalJsonDescription)
+	fmt.Println("    --journal-path, -i         " + journalPathDescription)
 	fmt.Println("    --custom-path, -p          " + pathDescription)
 	fmt.Println("    --docker-stream-only, -o   " + dockerStreamOnlyDescription)
 	fmt.Println("    --docker-context, -D       " + dockerContextDescription)
@@ -417,6 +488,7 @@ func showConfig() {
 	fmt.Printf("  journalField:             %s\n", config.Settings.JournalField)
 	fmt.Printf("  journalPriority:          %s\n", config.Settings.JournalPriority)
 	fmt.Printf("  journalBoot:              %s\n", config.Settings.JournalBoot)
+	fmt.Printf("  journalNamespace:         %s\n", config.Settings.JournalNamespace)
 	fmt.Printf("  customPath:               %s\n", config.Settings.CustomPath)
 	fmt.Printf("  dockerStreamOnly:         %s\n", config.Settings.DockerStreamOnly)
 	fmt.Printf("  dockerContext:            %s\n", config.Settings.DockerContext)
@@ -424,6 +496,16 @@ func showConfig() {
 	fmt.Printf("  kubernetesContext:        %s\n", config.Settings.KubernetesContext)
 	fmt.Printf("  kubernetesNamespace:      %s\n", config.Settings.KubernetesNamespace)
 	fmt.Printf("  disableFastMode:          %s\n", config.Settings.DisableFastMode)
+	fmt.Printf("  followMode:               %s\n", config.Settings.FollowMode)
+	fmt.Printf("  journalJson:              %s\n", config.Settings.JournalJson)
+	fmt.Printf("  journalPath:              %s\n", config.Settings.JournalPath)
+	fmt.Printf("  journalMatches:           %s\n", strings.Join(config.Settings.JournalMatches, ", "))
+	fmt.Printf("  auditLogPath:             %s\n", config.Settings.AuditLogPath)
+	var collections []string
+	for _, collection := range config.Settings.LogCollections {
+		collections = append(collections, collection.Name+" ("+strings.Join(collection.Include, ", ")+")")
+	}
+	fmt.Printf("  logCollections:           %s\n", strings.Join(collections, ", "))
 
 	fmt.Println("interface:")
 	fmt.Printf("  SystemLogList:            %s\n", config.Interface.SystemLogList)
@@ -470,9 +552,18 @@ func showConfig() {
 	fmt.Printf("  updateLists:              %s\n", config.Hotkeys.UpdateLists)
 	fmt.Printf("  switchColorMode:          %s\n", config.Hotkeys.SwitchColorMode)
 	fmt.Printf("  switchPriority:           %s\n", config.Hotkeys.SwitchPriority)
+	fmt.Printf("  switchNamespace:          %s\n", config.Hotkeys.SwitchNamespace)
 	fmt.Printf("  switchDockerMode:         %s\n", config.Hotkeys.SwitchDockerMode)
 	fmt.Printf("  switchStreamMode:         %s\n", config.Hotkeys.SwitchStreamMode)
 	fmt.Printf("  timestampShow:            %s\n", config.Hotkeys.TimestampShow)
+	fmt.Printf("  switchFollowMode:         %s\n", config.Hotkeys.SwitchFollowMode)
+	fmt.Printf("  switchJournalJson:        %s\n", config.Hotkeys.SwitchJournalJson)
+	fmt.Printf("  showFields:               %s\n", config.Hotkeys.ShowFields)
+	fmt.Printf("  markJournal:              %s\n", config.Hotkeys.MarkJournal)
+	fmt.Printf("  showUnit:                 %s\n", config.Hotkeys.ShowUnit)
+	fmt.Printf("  matchBuilder:             %s\n", config.Hotkeys.MatchBuilder)
+	fmt.Printf("  switchFamilyView:         %s\n", config.Hotkeys.SwitchFamilyView)
+	fmt.Printf("  sortFileList:             %s\n", config.Hotkeys.SortFileList)
 	fmt.Printf("  exit:                     %s\n", config.Hotkeys.Exit)
 
 	if len(config.Ssh.Hosts) >= 1 {
@@ -498,6 +589,8 @@ func winHomeDocsDir() string {
 func (app *App) showAudit() {
 	var auditText []string
 	app.testMode = true
+	// Аудит проверяет локальную систему, поэтому все команды (включая загрузку списков) выполняются локальным исполнителем
+	app.executor = app.localCmdExecutor()
 	app.getOS = runtime.GOOS
 
 	auditText = append(auditText,
@@ -623,8 +716,7 @@ func (app *App) showAudit() {
 		app.journalField = "SYSLOG_IDENTIFIER"
 		app.journalPriority = "debug"
 		app.journalBoot = "all"
-		csCheck := exec.Command("journalctl", "--version")
-		_, err := csCheck.Output()
+		_, err := app.runCommand("Check the binary", "journalctl", "--version")
 		if err == nil {
 			auditText = append(auditText,
 				"  - installed: true",
@@ -638,6 +730,7 @@ func (app *App) showAudit() {
 				{"User units", "userUnits"},
 				{"System journals", "systemJournals"},
 				{"Kernel boot", "kernelBoot"},
+				{"Core dumps", "coredumps"},
 			}
 			for _, journal := range journalList {
 				app.loadServices(journal.journalName)
@@ -654,10 +747,9 @@ func (app *App) showAudit() {
 		auditText = append(auditText,
 			"auditd:",
 		)
-		csCheck = exec.Command("dpkg-query", "-W", "-f='${Version}'", "auditd")
-		auditdVersion, err := csCheck.Output()
+		auditdVersion, err := app.runCommand("Check the binary", "dpkg-query", "-W", "-f=${Version}", "auditd")
 		if err == nil {
-			auditdVersionString := strings.ReplaceAll(string(auditdVersion), "'", "")
+			auditdVersionString := string(auditdVersion)
 			auditdVersionString = strings.Split(auditdVersionString, "-")[0]
 			auditText = append(auditText,
 				"  - installed: true",
@@ -665,13 +757,13 @@ func (app *App) showAudit() {
 			)
 			if os.Geteuid() == 0 {
 				app.loadServices("auditd")
-				lenRules := strconv.Itoa(len(app.journals))
+				lenGroups := strconv.Itoa(len(app.journals))
 				auditText = append(auditText,
-					"    rules: "+lenRules,
+					"    groups: "+lenGroups,
 				)
 			} else {
 				auditText = append(auditText,
-					"    rules: requires root access",
+					"    groups: requires root access",
 				)
 			}
 		} else {
@@ -723,12 +815,10 @@ func (app *App) showAudit() {
 		switch cs {
 		case "compose":
 			composeBin := "docker compose"
-			csCheck := exec.Command("docker", "compose", "version")
-			output, err := csCheck.Output()
+			output, err := app.runCommand("Check the binary", "docker", "compose", "version")
 			if err != nil {
 				composeBin = "docker-compose"
-				csCheck = exec.Command(composeBin, "version")
-				output, err = csCheck.Output()
+				output, err = app.runCommand("Check the binary", composeBin, "version")
 			}
 			if err == nil {
 				auditText = append(auditText, "    installed: true")
@@ -747,13 +837,11 @@ func (app *App) showAudit() {
 					}
 				}
 				auditText = append(auditText, "    version: "+csVersion)
-				var cmd *exec.Cmd
 				if composeBin == "docker compose" {
-					cmd = exec.Command("docker", "compose", "ls", "-a")
+					_, err = app.runCommand("Loading the compose stacks", "docker", "compose", "ls", "-a")
 				} else {
-					cmd = exec.Command(composeBin, "ls", "-a")
+					_, err = app.runCommand("Loading the compose stacks", composeBin, "ls", "-a")
 				}
-				_, err := cmd.Output()
 				if err == nil {
 					app.loadDockerContainer(cs)
 					auditText = append(auditText, "    stacks: "+strconv.Itoa(len(app.dockerContainers)))
@@ -765,8 +853,7 @@ func (app *App) showAudit() {
 			}
 		case "kubernetes":
 			cs = "kubectl"
-			csCheck := exec.Command(cs, "version")
-			output, _ := csCheck.Output()
+			output, _ := app.runCommand("Check the binary", cs, "version")
 			// По умолчанию у version код возврата всегда 1, по этому проверяем вывод
 			if strings.Contains(string(output), "Version:") {
 				auditText = append(auditText, "    installed: true")
@@ -786,11 +873,11 @@ func (app *App) showAudit() {
 					app.kubernetesNamespace = "--namespace=" + app.kubernetesNamespace
 				}
 				// kubectl pods
-				cmd := exec.Command(
+				_, err := app.runCommand(
+					"Loading the pods list",
 					cs, "get", "pods", "--context", app.kubernetesContext, app.kubernetesNamespace,
 					"-o", "jsonpath={range .items[*]}{.metadata.uid} {.metadata.name} {.status.phase}{'\\n'}{end}",
 				)
-				_, err = cmd.Output()
 				if err == nil {
 					app.loadDockerContainer(cs)
 					auditText = append(auditText, "    pods: "+strconv.Itoa(len(app.dockerContainers)))
@@ -800,10 +887,10 @@ func (app *App) showAudit() {
 				// kubectl context
 				auditText = append(auditText, "    context: ")
 				auditText = append(auditText, "      current: "+app.kubernetesContext)
-				cmd = exec.Command(
+				contexts, err := app.runCommand(
+					"Loading the kubernetes context list",
 					cs, "config", "get-contexts", "-o", "name", "--context", app.kubernetesContext,
 				)
-				contexts, err := cmd.Output()
 				if err == nil {
 					auditText = append(auditText, "      count: "+strconv.Itoa(len(strings.Split(strings.TrimSpace(string(contexts)), "\n"))))
 				} else {
@@ -812,10 +899,10 @@ func (app *App) showAudit() {
 				// kubectl namespace
 				auditText = append(auditText, "    namespace: ")
 				auditText = append(auditText, "      current: "+currentNamespace)
-				cmd = exec.Command(
+				namespaces, err := app.runCommand(
+					"Loading the kubernetes namespace list",
 					cs, "get", "namespaces", "-o", "name", "--context", app.kubernetesContext,
 				)
-				namespaces, err := cmd.Output()
 				if err == nil {
 					auditText = append(auditText, "      count: "+strconv.Itoa(len(strings.Split(strings.TrimSpace(string(namespaces)), "\n"))))
 				} else {
@@ -826,18 +913,14 @@ func (app *App) showAudit() {
 			}
 		// docker/podman case
 		default:
-			csCheck := exec.Command(cs, "--version")
-			output, err := csCheck.Output()
+			output, err := app.runCommand("Check the binary", cs, "--version")
 			if err == nil {
 				auditText = append(auditText, "    installed: true")
 				csVersion := strings.TrimSpace(string(output))
 				csVersion = strings.Split(csVersion, "version ")[1]
 				csVersion = strings.Split(csVersion, ", ")[0]
 				auditText = append(auditText, "    version: "+csVersion)
-				cmd := exec.Command(
-					cs, "ps", "-a",
-				)
-				_, err = cmd.Output()
+				_, err = app.runCommand("Loading the container list", cs, "ps", "-a")
 				if err == nil {
 					app.loadDockerContainer(cs)
 					auditText = append(auditText, "    containers: "+strconv.Itoa(len(app.dockerContainers)))
@@ -848,8 +931,7 @@ func (app *App) showAudit() {
 				if cs == "docker" {
 					auditText = append(auditText, "    context: ")
 					auditText = append(auditText, "      current: "+app.dockerContext)
-					cmd = exec.Command(cs, "context", "ls", "-q")
-					contexts, err := cmd.Output()
+					contexts, err := app.runCommand("Loading the docker context list", cs, "context", "ls", "-q")
 					if err == nil {
 						auditText = append(auditText, "      count: "+strconv.Itoa(len(strings.Split(strings.TrimSpace(string(contexts)), "\n"))))
 					} else {
@@ -904,6 +986,62 @@ func (config *Config) getConfig() (string, error) {
 	return configPath, err
 }
 
+// Функция для сохранения условий journald в файле конфигурации (комментарии и остальные параметры сохраняются)
+func saveJournalMatches(configPath string, matches []string) error {
+	var document yaml.Node
+	if data, err := os.ReadFile(configPath); err == nil {
+		if err := yaml.Unmarshal(data, &document); err != nil {
+			return fmt.Errorf("%w: %w", ErrYamlSyntax, err)
+		}
+	}
+	if len(document.Content) == 0 {
+		document = yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{{Kind: yaml.MappingNode}}}
+	}
+	settings := yamlMappingValue(document.Content[0], "settings", yaml.MappingNode)
+	matchesNode := yamlMappingValue(settings, "journalMatches", yaml.SequenceNode)
+	matchesNode.Kind = yaml.SequenceNode
+	matchesNode.Tag = "!!seq"
+	matchesNode.Style = 0
+	matchesNode.Value = ""
+	matchesNode.Content = nil
+	for _, match := range matches {
+		matchesNode.Content = append(matchesNode.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: match})
+	}
+	var buffer bytes.Buffer
+	encoder := yaml.NewEncoder(&buffer)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&document); err != nil {
+		return err
+	}
+	if err := encoder.Close(); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(configPath), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(configPath, buffer.Bytes(), 0644)
+}
+
+// Функция для получения значения по ключу в yaml (ключ добавляется, если отсутствует, пустое значение заменяется узлом нужного типа)
+func yamlMappingValue(mapping *yaml.Node, key string, kind yaml.Kind) *yaml.Node {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			value := mapping.Content[i+1]
+			// Ключ без значения (settings:) читается как null
+			if value.Kind == yaml.ScalarNode && value.Tag == "!!null" {
+				value.Kind = kind
+				value.Tag = ""
+				value.Value = ""
+				value.Style = 0
+			}
+			return value
+		}
+	}
+	value := &yaml.Node{Kind: kind}
+	mapping.Content = append(mapping.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key}, value)
+	return value
+}
+
 func (app *App) setupLogging() {
 	app.logging = true
 	logFile, err := os.OpenFile(
@@ -1006,19 +1144,14 @@ func (app *App) journalCheck(mode string) ([]string, error) {
 	case "boots":
 		flag = "--list-boots"
 	}
-	var cmd *exec.Cmd
-	if app.sshMode {
-		cmd = exec.Command(
-			"ssh", append(app.sshOptions,
-				cli, "--no-pager", flag,
-			)...)
-	} else {
-		cmd = exec.Command(
-			cli, "--no-pager", flag)
+	var args []string
+	if cli == "journalctl" {
+		args = app.journalSourceArgs()
 	}
-	output, err := cmd.Output()
+	args = append(args, "--no-pager", flag)
+	output, err := app.runCommand("Checking the "+mode+" list", cli, args...)
 	if err != nil {
-		return nil, fmt.Errorf("%s", string(output))
+		return nil, err
 	} else {
 		return strings.Split(strings.TrimSpace(string(output)), "\n"), nil
 	}
@@ -1026,23 +1159,212 @@ func (app *App) journalCheck(mode string) ([]string, error) {
 
 // Функция для опредиления название удаленной системы с timeout в 5 секунд
 func remoteGetOS(sshOptions []string) (string, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
-	cmd := exec.CommandContext(
-		ctx,
-		"ssh", append(
-			sshOptions, "uname", "-s",
-		)...)
-	cmd.WaitDelay = 5 * time.Second
-	// Извлекаем комбинированный вывод (захватываем stdout и stderr) для вывода ошибки подключения
-	output, err := cmd.CombinedOutput()
+	executor := &sshExecutor{options: sshOptions}
+	output, err := executor.Output(context.Background(), Command{
+		Name:    "uname",
+		Args:    []string{"-s"},
+		Timeout: 5 * time.Second,
+	})
 	if err != nil {
-		return "", fmt.Errorf("%s", strings.TrimSpace(string(output)))
+		// Возвращаем вывод stderr для отображения ошибки подключения
+		var cmdErr *CommandError
+		if errors.As(err, &cmdErr) && cmdErr.Stderr != "" {
+			return "", fmt.Errorf("%s", cmdErr.Stderr)
+		}
+		return "", err
 	} else {
 		return strings.ToLower(string(output)), nil
 	}
 }
 
+// ---------------------------------------- Command executor ----------------------------------------
+
+// Параметры команды для выполнения
+type Command struct {
+	Name    string        // исполняемый файл
+	Args    []string      // аргументы команды
+	Action  string        // описание действия для логирования
+	Stdin   io.Reader     // стандартный ввод (опционально)
+	Stderr  bool          // отдельный поток stderr при запуске через Start (по умолчанию сохраняется для ошибки)
+	Timeout time.Duration // ограничение времени выполнения (0 - без ограничения)
+}
+
+// Интерфейс для выполнения команд локально, через ssh или с подменой вывода в тестах
+type Executor interface {
+	// Выполнение команды с ожиданием завершения (ошибка содержит вывод stderr)
+	Output(ctx context.Context, cmd Command) ([]byte, error)
+	// Запуск команды с потоковым чтением вывода
+	Start(ctx context.Context, cmd Command) (*Process, error)
+}
+
+// Запущенный процесс с потоками вывода
+type Process struct {
+	Stdout io.ReadCloser
+	Stderr io.ReadCloser // nil, если в параметрах команды не запрошен отдельный поток stderr
+	wait   func() error
+}
+
+// Ожидание завершения процесса (вызывается после чтения всех потоков)
+func (p *Process) Wait() error {
+	return p.wait()
+}
+
+// Ошибка выполнения команды с выводом stderr
+type CommandError struct {
+	Err    error
+	Stderr string
+}
+
+func (e *CommandError) Error() string {
+	if e.Stderr == "" {
+		return e.Err.Error()
+	}
+	return e.Err.Error() + ": " + e.Stderr
+}
+
+func (e *CommandError) Unwrap() error {
+	return e.Err
+}
+
+// Выполнение команд в локальной системе
+type localExecutor struct {
+	logging bool
+}
+
+func (e *localExecutor) command(ctx context.Context, c Command) (*exec.Cmd, context.CancelFunc) {
+	cancel := context.CancelFunc(func() {})
+	if c.Timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, c.Timeout)
+	}
+	cmd := exec.CommandContext(ctx, c.Name, c.Args...)
+	cmd.Stdin = c.Stdin
+	// Ожидаем закрытие потоков вывода в течение 2-х секунд после отмены контекста
+	cmd.WaitDelay = 2 * time.Second
+	if e.logging && c.Action != "" {
+		slog.Info(cmd.String(), "action", c.Action)
+	}
+	return cmd, cancel
+}
+
+func (e *localExecutor) Output(ctx context.Context, c Command) ([]byte, error) {
+	cmd, cancel := e.command(ctx, c)
+	defer cancel()
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return stdout.Bytes(), &CommandError{Err: err, Stderr: strings.TrimSpace(stderr.String())}
+	}
+	return stdout.Bytes(), nil
+}
+
+func (e *localExecutor) Start(ctx context.Context, c Command) (*Process, error) {
+	cmd, cancel := e.command(ctx, c)
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	var stderrPipe io.ReadCloser
+	var stderr bytes.Buffer
+	if c.Stderr {
+		stderrPipe, err = cmd.StderrPipe()
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+	} else {
+		cmd.Stderr = &stderr
+	}
+	if err := cmd.Start(); err != nil {
+		cancel()
+		return nil, &CommandError{Err: err}
+	}
+	return &Process{
+		Stdout: stdout,
+		Stderr: stderrPipe,
+		wait: func() error {
+			defer cancel()
+			if err := cmd.Wait(); err != nil {
+				return &CommandError{Err: err, Stderr: strings.TrimSpace(stderr.String())}
+			}
+			return nil
+		},
+	}, nil
+}
+
+// Выполнение команд на удаленной системе через ssh
+type sshExecutor struct {
+	options []string
+	local   localExecutor
+}
+
+// Формируем команду ssh с экранированием аргументов для удаленной оболочки
+func (e *sshExecutor) remote(c Command) Command {
+	args := make([]string, 0, len(e.options)+1+len(c.Args))
+	args = append(args, e.options...)
+	args = append(args, shellQuote(c.Name))
+	for _, arg := range c.Args {
+		args = append(args, shellQuote(arg))
+	}
+	c.Name = "ssh"
+	c.Args = args
+	return c
+}
+
+func (e *sshExecutor) Output(ctx context.Context, c Command) ([]byte, error) {
+	return e.local.Output(ctx, e.remote(c))
+}
+
+func (e *sshExecutor) Start(ctx context.Context, c Command) (*Process, error) {
+	return e.local.Start(ctx, e.remote(c))
+}
+
+// Символы, которые не требуют экранирования в оболочке
+var shellSafeRegex = regexp.MustCompile(`^[a-zA-Z0-9_./:=@%+,-]+$`)
+
+// Функция для экранирования аргумента в одинарные кавычки
+func shellQuote(arg string) string {
+	if shellSafeRegex.MatchString(arg) {
+		return arg
+	}
+	return "'" + strings.ReplaceAll(arg, "'", `'\''`) + "'"
+}
+
+// Функция для получения исполнителя команд в зависимости от режима работы (локально или через ssh)
+func (app *App) cmdExecutor() Executor {
+	if app.executor != nil {
+		return app.executor
+	}
+	if app.sshMode {
+		return &sshExecutor{options: app.sshOptions, local: localExecutor{logging: app.logging}}
+	}
+	return &localExecutor{logging: app.logging}
+}
+
+// Функция для получения исполнителя команд, которые всегда выполняются локально (например, покраска через tailspin/bat)
+func (app *App) localCmdExecutor() Executor {
+	if app.executor != nil {
+		return app.executor
+	}
+	return &localExecutor{logging: app.logging}
+}
+
+// Функция для выполнения команды через текущий исполнитель с ожиданием завершения
+func (app *App) runCommand(action string, name string, args ...string) ([]byte, error) {
+	return app.runCommandContext(context.Background(), 0, action, name, args...)
+}
+
+// Функция для выполнения команды через текущий исполнитель с ограничением времени выполнения
+func (app *App) runCommandTimeout(timeout time.Duration, action string, name string, args ...string) ([]byte, error) {
+	return app.runCommandContext(context.Background(), timeout, action, name, args...)
+}
+
+// Функция для выполнения команды с отменой через контекст (процесс завершается при отмене загрузки журнала)
+func (app *App) runCommandContext(ctx context.Context, timeout time.Duration, action string, name string, args ...string) ([]byte, error) {
+	return app.cmdExecutor().Output(ctx, Command{Name: name, Args: args, Action: action, Timeout: timeout})
+}
+
 // ---------------------------------------- gocui ----------------------------------------
 
 // Объявляем GUI
@@ -1056,7 +1378,6 @@ func runGoCui(mock bool) {
 		testMode:                false,
 		mouseSupport:            true,
 		wrapSupport:             true,
-		dockerStreamLogs:        false,
 		dockerStreamMode:        "stream",
 		dockerContext:           "default",
 		podmanContext:           "nil",
@@ -1090,7 +1411,9 @@ func runGoCui(mock bool) {
 		lastCurrentView:         "services",
 		backCurrentView:         false,
 		dockerCompose:           "docker compose",
-		uniquePrefixColorMap:    make(map[string]string),
+		loadState: loadState{
+			uniquePrefixColorMap: make(map[string]string),
+		},
 	}
 
 	// Значения по умолчанию
@@ -1103,19 +1426,8 @@ func runGoCui(mock bool) {
 	// Определяем используемую ОС (linux/darwin/*bsd/windows) и архитектуру
 	app.getOS = runtime.GOOS
 	app.getArch = runtime.GOARCH
-	var composeVer *exec.Cmd
 	// Проверяем установку compose как плагин docker или docker-compose
-	if app.sshMode {
-		composeVer = exec.Command(
-			"ssh", append(app.sshOptions,
-				"docker", "compose", "version",
-			)...)
-	} else {
-		composeVer = exec.Command(
-			"docker", "compose", "version",
-		)
-	}
-	_, composeErr := composeVer.Output()
+	_, composeErr := app.runCommand("Check the binary", "docker", "compose", "version")
 	if composeErr != nil {
 		app.dockerCompose = "docker-compose"
 	}
@@ -1134,6 +1446,8 @@ func runGoCui(mock bool) {
 	// Общие настройки
 	disableScroll := flag.Bool("tail-mode-disable", false, tailModeDisableDescription)
 	flag.BoolVar(disableScroll, "d", false, tailModeDisableDescription)
+	followModeFlag := flag.Bool("follow-mode", false, followModeDescription)
+	flag.BoolVar(followModeFlag, "e", false, followModeDescription)
 	tailFlag := flag.String("tail-lines", "10000", tailLinesDescription)
 	flag.StringVar(tailFlag, "t", "10000", tailLinesDescription)
 	updateFlag := flag.Int("update-interval", 5, updateIntervalDescription)
@@ -1157,6 +1471,12 @@ func runGoCui(mock bool) {
 	flag.StringVar(journalPriorityFlag, "J", "debug", journalPriorityDescription)
 	journalBootFlag := flag.String("journal-boot", "all", journalBootDescription)
 	flag.StringVar(journalBootFlag, "b", "all", journalBootDescription)
+	journalNamespaceFlag := flag.String("journal-namespace", "", journalNamespaceDescription)
+	flag.StringVar(journalNamespaceFlag, "N", "", journalNamespaceDescription)
+	journalJsonFlag := flag.Bool("journal-json", false, journalJsonDescription)
+	flag.BoolVar(journalJsonFlag, "O", false, journalJsonDescription)
+	journalPathFlag := flag.String("journal-path", "", journalPathDescription)
+	flag.StringVar(journalPathFlag, "i", "", journalPathDescription)
 	pathFlag := flag.String("custom-path", "", pathDescription)
 	flag.StringVar(pathFlag, "p", "", pathDescription)
 	dockerStreamFlag := flag.Bool("docker-stream-only", false, dockerStreamOnlyDescription)
@@ -1324,6 +1644,28 @@ func runGoCui(mock bool) {
 		app.timezoneFilter = *timezoneFilterFlag
 	}
 
+	// -e/--follow-mode
+	if config.Settings.FollowMode != "" && !*followModeFlag {
+		if strings.EqualFold(config.Settings.FollowMode, "true") {
+			*followModeFlag = true
+		}
+	}
+
+	if *followModeFlag {
+		app.followMode = true
+	}
+
+	// -O/--journal-json
+	if config.Settings.JournalJson != "" && !*journalJsonFlag {
+		if strings.EqualFold(config.Settings.JournalJson, "true") {
+			*journalJsonFlag = true
+		}
+	}
+
+	if *journalJsonFlag {
+		app.journalJson = true
+	}
+
 	// -m/--mouse-disable
 	if config.Settings.MouseDisable != "" && !*mouseDisable {
 		if strings.EqualFold(config.Settings.MouseDisable, "true") {
@@ -1369,6 +1711,43 @@ func runGoCui(mock bool) {
 		}
 	}
 
+	// -i/--journal-path
+	if config.Settings.JournalPath != "" && *journalPathFlag == "" {
+		*journalPathFlag = config.Settings.JournalPath
+	}
+
+	// Проверяем, что каталог или файл журнала существует (кроме ssh, где путь относится к удаленной системе, и шаблонов файлов)
+	if *journalPathFlag != "" {
+		if _, err := os.Stat(*journalPathFlag); err != nil && *sshModeFlag == "" && !strings.ContainsAny(*journalPathFlag, "*?[") {
+			fmt.Println("Journal path " + *journalPathFlag + " not found")
+			os.Exit(1)
+		}
+		app.journalPath = *journalPathFlag
+	}
+
+	// -N/--journal-namespace
+	if config.Settings.JournalNamespace != "" && *journalNamespaceFlag == "" {
+		*journalNamespaceFlag = config.Settings.JournalNamespace
+	}
+
+	// Проверяем, что пространство имен существует (* - все пространства, +name - пространство вместе с журналом по умолчанию)
+	if *journalNamespaceFlag != "" {
+		if app.journalPath != "" {
+			fmt.Println("Journal namespace cannot be used with journal path")
+			os.Exit(1)
+		}
+		namespace := strings.TrimPrefix(*journalNamespaceFlag, "+")
+		if namespace != "*" && *sshModeFlag == "" {
+			namespaces := app.journalNamespaces()
+			if !slices.Contains(namespaces, namespace) {
+				fmt.Println("Journal namespace " + namespace + " not found")
+				fmt.Println("Available namespaces: " + strings.Join(namespaces, ", "))
+				os.Exit(1)
+			}
+		}
+		app.journalNamespace = *journalNamespaceFlag
+	}
+
 	// -U/--unit-types
 	if config.Settings.UnitType != "" && *unitTypeFlag == "service" {
 		app.unitType = config.Settings.UnitType
@@ -1393,6 +1772,18 @@ func runGoCui(mock bool) {
 		}
 	}
 
+	// Сохраненные условия journald для списка системных журналов
+	app.journalMatches = slices.Clone(config.Settings.JournalMatches)
+	app.auditLogPath = config.Settings.AuditLogPath
+	app.auditCache = &auditCache{}
+
+	// Коллекции без названия или шаблонов пропускаются
+	for _, collection := range config.Settings.LogCollections {
+		if collection.Name != "" && len(collection.Include) > 0 {
+			app.logCollections = append(app.logCollections, collection)
+		}
+	}
+
 	// -j/--journal-field
 	if config.Settings.JournalField != "" && *journalFieldFlag == "SYSLOG_IDENTIFIER" {
 		app.journalField = config.Settings.JournalField
@@ -1572,7 +1963,7 @@ func runGoCui(mock bool) {
 	// Определяем списки в панелях по умолчанию при запуске интерфейса (#37)
 
 	switch config.Interface.SystemLogList {
-	case "userUnits", "systemJournals", "kernelBoot", "auditd":
+	case "userUnits", "systemJournals", "kernelBoot", "auditd", "coredumps":
 		app.selectUnits = config.Interface.SystemLogList
 	default:
 		app.selectUnits = "systemUnits"
@@ -1583,6 +1974,10 @@ func runGoCui(mock bool) {
 		app.selectPath = config.Interface.FileLogList
 	default:
 		app.selectPath = "varlog"
+		// Список именованной коллекции файлов
+		if _, found := app.logCollection(logCollectionPrefix + config.Interface.FileLogList); found {
+			app.selectPath = logCollectionPrefix + config.Interface.FileLogList
+		}
 	}
 
 	switch config.Interface.ContainerLogList {
@@ -1796,9 +2191,9 @@ func runGoCui(mock bool) {
 		}
 		v.Title = " < Windows Event Logs (0) > "
 		// Загружаем список событий Windows в горутине
-		go func() {
+		app.loadListBackground(func(app *App) {
 			app.loadWinEvents()
-		}()
+		})
 	} else {
 		app.loadServices(app.selectUnits)
 	}
@@ -1824,9 +2219,9 @@ func runGoCui(mock bool) {
 		selectedVarLog.Title = " < Program Files (0) > "
 		app.selectPath = "ProgramFiles"
 		// Загружаем список файлов Windows в горутине
-		go func() {
+		app.loadListBackground(func(app *App) {
 			app.loadWinFiles(app.selectPath)
-		}()
+		})
 	} else {
 		app.loadFiles(app.selectPath)
 	}
@@ -1903,7 +2298,9 @@ func (app *App) layout(g *gocui.Gui) error {
 		case "kernelBoot":
 			v.Title = " < Kernel boot (0) > "
 		case "auditd":
-			v.Title = " < Audit rules keys (0) > "
+			v.Title = " < Audit events (0) > "
+		case "coredumps":
+			v.Title = " < Core dumps (0) > "
 		}
 		v.Highlight = true  // выделение активного элемента в списке
 		v.Wrap = false      // отключаем перенос строк
@@ -1922,16 +2319,7 @@ func (app *App) layout(g *gocui.Gui) error {
 		if !errors.Is(err, gocui.ErrUnknownView) {
 			return err
 		}
-		switch app.selectPath {
-		case "varlog":
-			v.Title = " < System var logs (0) > "
-		case "customPath":
-			v.Title = " < Custom path - " + app.customPath + " (0) > "
-		case "home":
-			v.Title = " < Users home logs (0) > "
-		case "descriptor":
-			v.Title = " < Process descriptor logs (0) > "
-		}
+		v.Title = app.fileLogListTitle(app.selectPath)
 		v.Highlight = true
 		v.Wrap = false
 		v.Autoscroll = true
@@ -2026,7 +2414,10 @@ func (app *App) layout(g *gocui.Gui) error {
 				"Color mode: \033[32m%s\033[0m | "+
 				"Filter by date: \033[32m%s\033[0m | "+
 				"Filter by priority/boot: \033[32m%s\033[0m/\033[32m%s\033[0m | "+
-				"Show timestamp: \033[32m%t\033[0m \n "+
+				"Show timestamp: \033[32m%t\033[0m | "+
+				"Follow mode: \033[32m%t\033[0m \n "+
+				"Journal JSON: \033[32m%t\033[0m | "+
+				"Journal path/namespace: \033[32m%s\033[0m/\033[32m%s\033[0m | "+
 				"SSH mode: \033[32m%s\033[0m | "+
 				"Docker mode/context: \033[32m%s\033[0m/\033[32m%s\033[0m | "+
 				"Kubernetes context/namespace: \033[32m%s\033[0m/\033[32m%s\033[0m",
@@ -2038,6 +2429,10 @@ func (app *App) layout(g *gocui.Gui) error {
 			app.journalPriority,
 			app.journalBoot,
 			app.timestampDocker,
+			app.followMode,
+			app.journalJson,
+			app.journalPathStatus(),
+			app.journalNamespaceStatus(),
 			app.sshStatus,
 			app.dockerStreamLogsStatus,
 			app.dockerContext,
@@ -2065,90 +2460,67 @@ func removeANSI(input string) string {
 	return ansiEscapeRegex.ReplaceAllString(input, "")
 }
 
+// Форматы дат в названиях загрузок ядра и суффиксах ротированных файлов
+var nameDateFormats = []string{"02.01.2006 15:04:05", "20060102", "2006-01-02", "02.01.2006"}
+
+// Функция для извлечения даты из строки в одном из форматов названий (нулевое время, если формат не распознан)
+func extractDate(text string) time.Time {
+	for _, dateFormat := range nameDateFormats {
+		if parsedDate, err := time.Parse(dateFormat, text); err == nil {
+			return parsedDate
+		}
+	}
+	return time.Time{}
+}
+
 // Функция для извлечения даты из строки для списка загрузок ядра
 func parseDateFromName(name string) time.Time {
 	cleanName := removeANSI(name)
-	dateFormat := "02.01.2006 15:04:05"
 	// Извлекаем дату, начиная с 22-го символа (после дефиса)
-	parsedDate, _ := time.Parse(dateFormat, cleanName[22:])
-	return parsedDate
+	return extractDate(cleanName[22:])
 }
 
 // Функция для загрузки списка журналов служб или загрузок системы из journald с помощью journalctl
 func (app *App) loadServices(journalName string) {
 	app.journals = nil
 	// Проверка, что в системе установлена и поддерживается утилита journalctl
-	var checkJournald *exec.Cmd
-	if app.sshMode {
-		checkJournald = exec.Command(
-			"ssh", append(app.sshOptions,
-				"journalctl", "--version",
-			)...)
-	} else {
-		checkJournald = exec.Command(
-			"journalctl", "--version",
-		)
-	}
+	_, err := app.runCommand("Check the binary", "journalctl", "--version")
 	// Проверяем на ошибки (очищаем список служб, отключаем курсор и выводим ошибку)
-	if app.logging {
-		slog.Info(checkJournald.String(), "action", "Check the binary")
-	}
-	_, err := checkJournald.Output()
 	if err != nil && !app.testMode {
-		vError, _ := app.gui.View("services")
-		vError.Clear()
-		app.journalListFrameColor = app.errorColor
-		vError.FrameColor = app.journalListFrameColor
-		vError.Highlight = false
-		fmt.Fprintln(vError, "\033[31msystemd-journald not supported\033[0m")
+		app.uiUpdate(func(app *App) {
+			vError, _ := app.gui.View("services")
+			vError.Clear()
+			app.journalListFrameColor = app.errorColor
+			vError.FrameColor = app.journalListFrameColor
+			vError.Highlight = false
+			fmt.Fprintln(vError, "\033[31msystemd-journald not supported\033[0m")
+		})
 		return
 	}
 	if err != nil && app.testMode {
 		log.Print("Error: systemd-journald not supported")
 	}
 	switch journalName {
-	// Unit list from systemd
+	// Unit list from offline journal
 	case "systemUnits", "userUnits":
 		app.journals = append(app.journals, Journal{
 			name:    "_all",
 			boot_id: "_all",
 		})
+		if app.journalFieldUnits() {
+			app.loadJournalUnits(journalName)
+			return
+		}
 		// (1) Получаем список всех юнитов со статусом работы через systemctl в формате JSON
-		var unitsList *exec.Cmd
 		var unitTypeFlag = "--type=" + app.unitType // "service,timer,scope,socket,mount" (default: service)
-		if app.sshMode {
-			if journalName == "systemUnits" {
-				unitsList = exec.Command(
-					"ssh", append(app.sshOptions,
-						"systemctl", "list-units", "--all", unitTypeFlag, "--no-legend", "--no-pager", "--output=json",
-					)...)
-			} else {
-				unitsList = exec.Command(
-					"ssh", append(app.sshOptions,
-						"systemctl", "--user", "list-units", "--all", unitTypeFlag, "--no-legend", "--no-pager", "--output=json",
-					)...)
-			}
-		} else {
-			if journalName == "systemUnits" {
-				unitsList = exec.Command(
-					"systemctl", "list-units", "--all", unitTypeFlag, "--no-legend", "--no-pager", "--output=json",
-				)
-			} else {
-				unitsList = exec.Command(
-					"systemctl", "--user", "list-units", "--all", unitTypeFlag, "--no-legend", "--no-pager", "--output=json",
-				)
-			}
-		}
-		if app.logging {
-			var logSource string
-			if journalName == "systemUnits" {
-				logSource = "Loading the system units"
-			} else {
-				logSource = "Loading the user units"
-			}
-			slog.Info(unitsList.String(), "action", logSource)
-		}
-		output, err := unitsList.Output()
+		var unitsArgs []string
+		logSource := "Loading the system units"
+		if journalName == "userUnits" {
+			unitsArgs = append(unitsArgs, "--user")
+			logSource = "Loading the user units"
+		}
+		unitsArgs = append(unitsArgs, "list-units", "--all", unitTypeFlag, "--no-legend", "--no-pager", "--output=json")
+		output, err := app.runCommand(logSource, "systemctl", unitsArgs...)
 		if !app.testMode {
 			if err != nil {
 				vError, _ := app.gui.View("services")
@@ -2194,27 +2566,10 @@ func (app *App) loadServices(journalName string) {
 			}
 		}
 		// (2) Получаем список всех юнит-файлов для извлечения статуса автозагрузки и отключенных сервисов
-		var unitFilesList *exec.Cmd
-		if app.sshMode {
-			unitFilesList = exec.Command(
-				"ssh", append(app.sshOptions,
-					"systemctl", "list-unit-files", unitTypeFlag, "--all", "--no-legend", "--no-pager", "--output=json", "--state=enabled,disabled",
-				)...)
-		} else {
-			unitFilesList = exec.Command(
-				"systemctl", "list-unit-files", unitTypeFlag, "--all", "--no-legend", "--no-pager", "--output=json", "--state=enabled,disabled",
-			)
-		}
-		if app.logging {
-			var logSource string
-			if journalName == "systemUnits" {
-				logSource = "Loading the system units"
-			} else {
-				logSource = "Loading the user units"
-			}
-			slog.Info(unitFilesList.String(), "action", logSource)
-		}
-		output, _ = unitFilesList.Output()
+		output, _ = app.runCommand(
+			logSource,
+			"systemctl", "list-unit-files", unitTypeFlag, "--all", "--no-legend", "--no-pager", "--output=json", "--state=enabled,disabled",
+		)
 		var unitFiles []map[string]any
 		err = json.Unmarshal(output, &unitFiles)
 		if err != nil {
@@ -2306,21 +2661,7 @@ func (app *App) loadServices(journalName string) {
 		})
 		// journalctl -n 1 -o json | jq keys
 		var fieldFlag = "--field=" + app.journalField // SYSLOG_IDENTIFIER/_UID/_PID/_COMM/_EXE/_CMDLINE
-		var cmd *exec.Cmd
-		if app.sshMode {
-			cmd = exec.Command(
-				"ssh", append(app.sshOptions,
-					"journalctl", "--no-pager", fieldFlag,
-				)...)
-		} else {
-			cmd = exec.Command(
-				"journalctl", "--no-pager", fieldFlag,
-			)
-		}
-		if app.logging {
-			slog.Info(cmd.String(), "action", "Loading the system journals")
-		}
-		output, err := cmd.Output()
+		output, err := app.runCommand("Loading the system journals", "journalctl", append(app.journalSourceArgs(), "--no-pager", fieldFlag)...)
 		if !app.testMode {
 			if err != nil {
 				vError, _ := app.gui.View("services")
@@ -2359,24 +2700,22 @@ func (app *App) loadServices(journalName string) {
 		sort.Slice(app.journals, func(i, j int) bool {
 			return app.journals[i].name < app.journals[j].name
 		})
+		// Сохраненные условия выводятся после _all
+		var matchJournals []Journal
+		for _, match := range app.journalMatches {
+			matchJournals = append(matchJournals, Journal{
+				name:    "[\033[36mmatch\033[0m] " + match,
+				boot_id: match,
+			})
+		}
+		allIndex := slices.IndexFunc(app.journals, func(journal Journal) bool {
+			return journal.name == "_all"
+		})
+		app.journals = slices.Insert(app.journals, allIndex+1, matchJournals...)
 	// Kernel boot list from journald
 	case "kernelBoot":
 		// Получаем список загрузок системы
-		var cmd *exec.Cmd
-		if app.sshMode {
-			cmd = exec.Command(
-				"ssh", append(app.sshOptions,
-					"journalctl", "--list-boots", "-o", "json",
-				)...)
-		} else {
-			cmd = exec.Command(
-				"journalctl", "--list-boots", "-o", "json",
-			)
-		}
-		if app.logging {
-			slog.Info(cmd.String(), "action", "Loading the kernel boot")
-		}
-		bootOutput, err := cmd.Output()
+		bootOutput, err := app.runCommand("Loading the kernel boot", "journalctl", append(app.journalSourceArgs(), "--list-boots", "-o", "json")...)
 		if !app.testMode {
 			if err != nil {
 				vError, _ := app.gui.View("services")
@@ -2467,39 +2806,48 @@ func (app *App) loadServices(journalName string) {
 			// Сравниваем по второй дате в обратном порядке (After для сортировки по убыванию)
 			return date1.After(date2)
 		})
-	// Audit rules keys from auditd
+	// Audit events from audit.log grouped by rule key, record type, user and executable
 	case "auditd":
-		// Получаем список правил
-		var cmd *exec.Cmd
-		if app.sshMode {
-			cmd = exec.Command(
-				"ssh", append(app.sshOptions,
-					"auditctl", "-l",
-				)...)
-		} else {
-			cmd = exec.Command(
-				"auditctl", "-l",
-			)
+		// Список может загружаться в копии приложения, поэтому окно изменяется через uiUpdate
+		events, err := app.loadAuditEvents(context.Background())
+		if err != nil && !app.testMode {
+			app.uiUpdate(func(app *App) {
+				vError, _ := app.gui.View("services")
+				vError.Clear()
+				app.journalListFrameColor = app.errorColor
+				vError.FrameColor = app.journalListFrameColor
+				vError.Highlight = false
+				fmt.Fprintln(vError, "\033[31mError reading audit log (root only):", err, "\033[0m")
+			})
+			return
+		}
+		app.uiUpdate(func(app *App) {
+			v, _ := app.gui.View("services")
+			app.journalListFrameColor = app.frameColor
+			if v.FrameColor != app.frameColor {
+				v.FrameColor = app.selectedFrameColor
+			}
+			v.Highlight = true
+		})
+		if err != nil && app.testMode {
+			log.Print("Error: reading audit log. ", err)
 		}
-		if app.logging {
-			slog.Info(cmd.String(), "action", "Loading the audit rules keys")
+		app.journals = append(app.journals, auditEventGroups(events)...)
+	// Core dumps list from systemd-coredump
+	case "coredumps":
+		output, err := app.runCommand("Loading the core dumps", "coredumpctl", append(app.coredumpSourceArgs(), "list", "--json=short", "--no-pager")...)
+		// coredumpctl завершается с ошибкой, если в журнале нет ни одного дампа
+		if err != nil && strings.Contains(err.Error(), "No coredumps found") {
+			err = nil
 		}
-		output, err := cmd.Output()
-		// Проверяем, что auditd установлен и на ошибку доступа
 		if !app.testMode {
 			if err != nil {
-				var errorText string
-				if err.Error() == "exit status 4" {
-					errorText = "Access denied in auditd via auditctl (root only)"
-				} else {
-					errorText = "Auditd not installed"
-				}
 				vError, _ := app.gui.View("services")
 				vError.Clear()
 				app.journalListFrameColor = app.errorColor
 				vError.FrameColor = app.journalListFrameColor
 				vError.Highlight = false
-				fmt.Fprintln(vError, "\033[31m"+errorText+"\033[0m")
+				fmt.Fprintln(vError, "\033[31mError getting core dumps via coredumpctl\033[0m")
 				return
 			}
 			v, _ := app.gui.View("services")
@@ -2510,3125 +2858,5944 @@ func (app *App) loadServices(journalName string) {
 			v.Highlight = true
 		}
 		if err != nil && app.testMode {
-			if strings.Contains(err.Error(), "root to run") {
-				log.Print("Access denied in auditd via auditctl (root only)")
-			} else {
-				log.Print("Auditd not installed")
-			}
-		}
-		// Заполняем список всех уникальный ключей
-		keysMap := make(map[string]bool)
-		scanner := bufio.NewScanner(strings.NewReader(string(output)))
-		for scanner.Scan() {
-			rule := scanner.Text()
-			if strings.Contains(rule, "-k ") {
-				// Разбиваем строку правила на 2 части (split) до ключа
-				rulePart := strings.Split(rule, "-k ")
-				if len(rulePart) > 1 {
-					// Разбиваем на слова (fields) из второй части правила после ключа и извлекаем первое слово
-					keyPart := strings.Fields(rulePart[1])[0]
-					if !keysMap[keyPart] {
-						keysMap[keyPart] = true
-						app.journals = append(app.journals, Journal{
-							name:    keyPart,
-							boot_id: keyPart,
-						})
-					}
-				}
-			}
+			log.Print("Error: getting core dumps via coredumpctl")
 		}
+		app.journals = append(app.journals, parseCoredumps(output)...)
 	}
-	if !app.testMode {
+	journals := app.journals
+	app.uiUpdate(func(app *App) {
+		// Пропускаем список, загруженный в горутине, если за время загрузки выбран другой журнал
+		if app.selectUnits != journalName {
+			return
+		}
+		app.journals = journals
 		// Сохраняем неотфильтрованный список
 		app.journalsNotFilter = app.journals
 		// Применяем фильтр при загрузки и обновляем список служб в интерфейсе через updateServicesList() внутри функции
 		app.applyFilterList()
+	})
+}
+
+// Функция для загрузки выбранного списка журналов (события аудита читаются и разбираются в горутине)
+func (app *App) loadSelectedServices() {
+	if app.selectUnits != "auditd" || app.testMode {
+		app.loadServices(app.selectUnits)
+		return
+	}
+	if v, err := app.gui.View("services"); err == nil {
+		v.Clear()
+		v.Highlight = false
+		fmt.Fprintln(v, "Loading audit events...")
 	}
+	app.loadListBackground(func(app *App) {
+		app.loadServices(app.selectUnits)
+	})
 }
 
-// Функция для загрузки списка всех журналов событий Windows через PowerShell
-func (app *App) loadWinEvents() {
-	app.debugStartTime = time.Now()
-	app.journals = nil
-	// Получаем список, игнорируем ошибки, фильтруем пустые журналы, забираем нужные параметры, сортируем и выводим в формате JSON
-	cmd := exec.Command("powershell", "-Command",
-		"Get-WinEvent -ListLog * -ErrorAction Ignore | "+
-			"Where-Object RecordCount -ne 0 | "+
-			"Where-Object RecordCount -ne $null | "+
-			"Select-Object LogName,RecordCount | "+
-			"Sort-Object -Descending RecordCount | "+
-			"ConvertTo-Json")
-	if app.logging {
-		slog.Info(cmd.String(), "action", "Loading the windows event logs")
-	}
-	eventsJson, _ := cmd.Output()
-	var events []map[string]any
-	_ = json.Unmarshal(eventsJson, &events)
-	for _, event := range events {
-		// Извлечение названия журнала и количество записей
-		LogName, _ := event["LogName"].(string)
-		RecordCount, _ := event["RecordCount"].(float64)
-		RecordCountInt := int(RecordCount)
-		RecordCountString := strconv.Itoa(RecordCountInt)
-		// Удаляем приставку
-		LogView := strings.ReplaceAll(LogName, "Microsoft-Windows-", "")
-		// Разбивает строку на 2 части для покраски
-		LogViewSplit := strings.SplitN(LogView, "/", 2)
-		if len(LogViewSplit) == 2 {
-			LogView = "\033[33m" + LogViewSplit[0] + "\033[0m" + ": " + "\033[36m" + LogViewSplit[1] + "\033[0m"
-		} else {
-			LogView = "\033[36m" + LogView + "\033[0m"
+// Названия сигналов, которые приводят к созданию дампа памяти
+var coredumpSignals = map[int]string{
+	3:  "SIGQUIT",
+	4:  "SIGILL",
+	5:  "SIGTRAP",
+	6:  "SIGABRT",
+	7:  "SIGBUS",
+	8:  "SIGFPE",
+	11: "SIGSEGV",
+	24: "SIGXCPU",
+	25: "SIGXFSZ",
+	31: "SIGSYS",
+}
+
+// Функция для формирования списка дампов памяти из вывода coredumpctl list --json=short (последние дампы в начале списка)
+func parseCoredumps(output []byte) []Journal {
+	type CoredumpInfo struct {
+		Time     int64  `json:"time"`
+		Pid      int    `json:"pid"`
+		Sig      int    `json:"sig"`
+		Corefile string `json:"corefile"`
+		Exe      string `json:"exe"`
+	}
+	var coredumps []CoredumpInfo
+	if err := json.Unmarshal(output, &coredumps); err != nil {
+		return nil
+	}
+	journals := make([]Journal, 0, len(coredumps))
+	for i := len(coredumps) - 1; i >= 0; i-- {
+		coredump := coredumps[i]
+		signal, ok := coredumpSignals[coredump.Sig]
+		if !ok {
+			signal = "SIG" + strconv.Itoa(coredump.Sig)
 		}
-		LogView = LogView + " (" + RecordCountString + ")"
-		app.journals = append(app.journals, Journal{
-			name:    LogView,
-			boot_id: LogName,
+		const dateFormat = "02.01.2006 15:04:05"
+		name := fmt.Sprintf("\033[34m%s\033[0m %s[%d] \033[31m%s\033[0m (%s)",
+			time.UnixMicro(coredump.Time).Format(dateFormat), filepath.Base(coredump.Exe), coredump.Pid, signal, coredump.Corefile,
+		)
+		// Дамп определяется по PID и времени записи в журнал, так как PID может повторяться
+		journals = append(journals, Journal{
+			name:    name,
+			boot_id: fmt.Sprintf("%d %d", coredump.Pid, coredump.Time),
 		})
 	}
-	if !app.testMode {
-		app.journalsNotFilter = app.journals
-		app.applyFilterList()
+	return journals
+}
+
+// Функция для формирования аргументов источника coredumpctl (пространства имен journald не поддерживаются)
+func (app *App) coredumpSourceArgs() []string {
+	if app.journalPath == "" {
+		return nil
 	}
+	return app.journalSourceArgs()
 }
 
-// Функция для обновления окна со списком служб
-func (app *App) updateServicesList() {
-	// Выбираем окно для заполнения в зависимости от используемого журнала
-	v, err := app.gui.View("services")
+// Интервал вывода сообщений журнала до и после создания дампа памяти
+const coredumpJournalWindow = 30 * time.Second
+
+// Функция для получения информации о дампе памяти (сигнал, исполняемый файл и стек вызовов) и сообщений журнала в момент сбоя
+func (app *App) loadCoredumpInfo(ctx context.Context, coredumpId string) ([]byte, error) {
+	var pid, timestamp int64
+	if _, err := fmt.Sscanf(coredumpId, "%d %d", &pid, &timestamp); err != nil {
+		return nil, fmt.Errorf("unexpected core dump id %q", coredumpId)
+	}
+	// Время из списка (time) является временем записи в журнал и не совпадает с полем COREDUMP_TIMESTAMP,
+	// поэтому дамп выбирается по PID среди записей в пределах секунды
+	crashTime := time.UnixMicro(timestamp)
+	output, err := app.runCommandContext(ctx, 0,
+		"Reading core dump information",
+		"coredumpctl", append(app.coredumpSourceArgs(),
+			"info", "--no-pager",
+			"--since=@"+strconv.FormatInt(crashTime.Unix(), 10),
+			"--until=@"+strconv.FormatInt(crashTime.Unix()+1, 10),
+			"COREDUMP_PID="+strconv.FormatInt(pid, 10),
+		)...,
+	)
 	if err != nil {
-		return
+		return output, err
+	}
+	journalOutput, err := app.runCommandContext(ctx, 0,
+		"Reading logs around the core dump",
+		"journalctl", append(app.coredumpSourceArgs(),
+			"--since=@"+strconv.FormatInt(crashTime.Add(-coredumpJournalWindow).Unix(), 10),
+			"--until=@"+strconv.FormatInt(crashTime.Add(coredumpJournalWindow).Unix(), 10),
+			"--no-pager",
+		)...,
+	)
+	if err != nil {
+		return output, err
 	}
-	// Очищаем окно
-	v.Clear()
-	// Вычисляем конечную позицию видимой области (стартовая позиция + максимальное количество видимых строк)
-	visibleEnd := min(app.startServices+app.maxVisibleServices, len(app.journals))
-	// Отображаем только элементы в пределах видимой области
-	for i := app.startServices; i < visibleEnd; i++ {
-		fmt.Fprintln(v, app.journals[i].name)
+	output = append(output, []byte("\n\033[35m⎯⎯⎯\033[0m Journal messages around the crash (±"+coredumpJournalWindow.String()+") \033[35m⎯⎯⎯\033[0m\n\n")...)
+	return append(output, journalOutput...), nil
+}
+
+// Путь к журналу auditd по умолчанию (log_file в auditd.conf)
+const auditLogDefaultPath = "/var/log/audit/audit.log"
+
+// Поле записи аудита в исходном порядке (значение декодировано и интерпретировано)
+type auditField struct {
+	name  string
+	value string
+}
+
+// Запись журнала аудита (строка type=... msg=audit(...))
+type auditRecord struct {
+	recordType string
+	fields     []auditField
+}
+
+// Событие аудита: записи с одинаковым временем и порядковым номером msg=audit(ts:serial)
+type auditEvent struct {
+	id        string // время и порядковый номер события (ts:serial)
+	serial    string
+	timestamp time.Time
+	node      string
+	records   []auditRecord
+}
+
+// Заголовок записи аудита (node=host type=TYPE msg=audit(1700000000.123:456): поля)
+var auditRecordRegex = regexp.MustCompile(`^(?:node=(\S+) )?type=(\S+) msg=audit\((\d+)\.(\d+):(\d+)\):\s*(.*)$`)
+
+// Поля, значения которых записываются в hex, если содержат пробелы или спецсимволы (без кавычек)
+var auditHexFields = []string{"proctitle", "exe", "comm", "cwd", "name", "key", "acct", "cmd", "data", "path", "ocomm"}
+
+// Поля с идентификаторами пользователей, которые выводятся по имени
+var auditUidFields = []string{"auid", "uid", "euid", "suid", "fsuid", "ouid", "sauid", "old-auid", "inode_uid", "obj_uid"}
+
+// Архитектуры процессора в поле arch
+var auditArchNames = map[string]string{
+	"c000003e": "x86_64",
+	"c00000b7": "aarch64",
+	"40000003": "i386",
+	"40000028": "arm",
+}
+
+// Номера системных вызовов по архитектурам (полные таблицы из заголовков ядра, совпадают с выводом ausyscall --dump)
+// Для архитектур без таблицы выводится номер системного вызова
+var auditSyscallNames = map[string]map[string]string{
+	"x86_64": {
+		"0": "read", "1": "write", "2": "open", "3": "close", "4": "stat", "5": "fstat", "6": "lstat", "7": "poll", "8": "lseek",
+		"9": "mmap", "10": "mprotect", "11": "munmap", "12": "brk", "13": "rt_sigaction", "14": "rt_sigprocmask",
+		"15": "rt_sigreturn", "16": "ioctl", "17": "pread64", "18": "pwrite64", "19": "readv", "20": "writev", "21": "access",
+		"22": "pipe", "23": "select", "24": "sched_yield", "25": "mremap", "26": "msync", "27": "mincore", "28": "madvise",
+		"29": "shmget", "30": "shmat", "31": "shmctl", "32": "dup", "33": "dup2", "34": "pause", "35": "nanosleep",
+		"36": "getitimer", "37": "alarm", "38": "setitimer", "39": "getpid", "40": "sendfile", "41": "socket", "42": "connect",
+		"43": "accept", "44": "sendto", "45": "recvfrom", "46": "sendmsg", "47": "recvmsg", "48": "shutdown", "49": "bind",
+		"50": "listen", "51": "getsockname", "52": "getpeername", "53": "socketpair", "54": "setsockopt", "55": "getsockopt",
+		"56": "clone", "57": "fork", "58": "vfork", "59": "execve", "60": "exit", "61": "wait4", "62": "kill", "63": "uname",
+		"64": "semget", "65": "semop", "66": "semctl", "67": "shmdt", "68": "msgget", "69": "msgsnd", "70": "msgrcv",
+		"71": "msgctl", "72": "fcntl", "73": "flock", "74": "fsync", "75": "fdatasync", "76": "truncate", "77": "ftruncate",
+		"78": "getdents", "79": "getcwd", "80": "chdir", "81": "fchdir", "82": "rename", "83": "mkdir", "84": "rmdir",
+		"85": "creat", "86": "link", "87": "unlink", "88": "symlink", "89": "readlink", "90": "chmod", "91": "fchmod",
+		"92": "chown", "93": "fchown", "94": "lchown", "95": "umask", "96": "gettimeofday", "97": "getrlimit", "98": "getrusage",
+		"99": "sysinfo", "100": "times", "101": "ptrace", "102": "getuid", "103": "syslog", "104": "getgid", "105": "setuid",
+		"106": "setgid", "107": "geteuid", "108": "getegid", "109": "setpgid", "110": "getppid", "111": "getpgrp",
+		"112": "setsid", "113": "setreuid", "114": "setregid", "115": "getgroups", "116": "setgroups", "117": "setresuid",
+		"118": "getresuid", "119": "setresgid", "120": "getresgid", "121": "getpgid", "122": "setfsuid", "123": "setfsgid",
+		"124": "getsid", "125": "capget", "126": "capset", "127": "rt_sigpending", "128": "rt_sigtimedwait",
+		"129": "rt_sigqueueinfo", "130": "rt_sigsuspend", "131": "sigaltstack", "132": "utime", "133": "mknod", "134": "uselib",
+		"135": "personality", "136": "ustat", "137": "statfs", "138": "fstatfs", "139": "sysfs", "140": "getpriority",
+		"141": "setpriority", "142": "sched_setparam", "143": "sched_getparam", "144": "sched_setscheduler",
+		"145": "sched_getscheduler", "146": "sched_get_priority_max", "147": "sched_get_priority_min",
+		"148": "sched_rr_get_interval", "149": "mlock", "150": "munlock", "151": "mlockall", "152": "munlockall",
+		"153": "vhangup", "154": "modify_ldt", "155": "pivot_root", "156": "_sysctl", "157": "prctl", "158": "arch_prctl",
+		"159": "adjtimex", "160": "setrlimit", "161": "chroot", "162": "sync", "163": "acct", "164": "settimeofday",
+		"165": "mount", "166": "umount2", "167": "swapon", "168": "swapoff", "169": "reboot", "170": "sethostname",
+		"171": "setdomainname", "172": "iopl", "173": "ioperm", "174": "create_module", "175": "init_module",
+		"176": "delete_module", "177": "get_kernel_syms", "178": "query_module", "179": "quotactl", "180": "nfsservctl",
+		"181": "getpmsg", "182": "putpmsg", "183": "afs_syscall", "184": "tuxcall", "185": "security", "186": "gettid",
+		"187": "readahead", "188": "setxattr", "189": "lsetxattr", "190": "fsetxattr", "191": "getxattr", "192": "lgetxattr",
+		"193": "fgetxattr", "194": "listxattr", "195": "llistxattr", "196": "flistxattr", "197": "removexattr",
+		"198": "lremovexattr", "199": "fremovexattr", "200": "tkill", "201": "time", "202": "futex", "203": "sched_setaffinity",
+		"204": "sched_getaffinity", "205": "set_thread_area", "206": "io_setup", "207": "io_destroy", "208": "io_getevents",
+		"209": "io_submit", "210": "io_cancel", "211": "get_thread_area", "212": "lookup_dcookie", "213": "epoll_create",
+		"214": "epoll_ctl_old", "215": "epoll_wait_old", "216": "remap_file_pages", "217": "getdents64",
+		"218": "set_tid_address", "219": "restart_syscall", "220": "semtimedop", "221": "fadvise64", "222": "timer_create",
+		"223": "timer_settime", "224": "timer_gettime", "225": "timer_getoverrun", "226": "timer_delete", "227": "clock_settime",
+		"228": "clock_gettime", "229": "clock_getres", "230": "clock_nanosleep", "231": "exit_group", "232": "epoll_wait",
+		"233": "epoll_ctl", "234": "tgkill", "235": "utimes", "236": "vserver", "237": "mbind", "238": "set_mempolicy",
+		"239": "get_mempolicy", "240": "mq_open", "241": "mq_unlink", "242": "mq_timedsend", "243": "mq_timedreceive",
+		"244": "mq_notify", "245": "mq_getsetattr", "246": "kexec_load", "247": "waitid", "248": "add_key", "249": "request_key",
+		"250": "keyctl", "251": "ioprio_set", "252": "ioprio_get", "253": "inotify_init", "254": "inotify_add_watch",
+		"255": "inotify_rm_watch", "256": "migrate_pages", "257": "openat", "258": "mkdirat", "259": "mknodat",
+		"260": "fchownat", "261": "futimesat", "262": "newfstatat", "263": "unlinkat", "264": "renameat", "265": "linkat",
+		"266": "symlinkat", "267": "readlinkat", "268": "fchmodat", "269": "faccessat", "270": "pselect6", "271": "ppoll",
+		"272": "unshare", "273": "set_robust_list", "274": "get_robust_list", "275": "splice", "276": "tee",
+		"277": "sync_file_range", "278": "vmsplice", "279": "move_pages", "280": "utimensat", "281": "epoll_pwait",
+		"282": "signalfd", "283": "timerfd_create", "284": "eventfd", "285": "fallocate", "286": "timerfd_settime",
+		"287": "timerfd_gettime", "288": "accept4", "289": "signalfd4", "290": "eventfd2", "291": "epoll_create1", "292": "dup3",
+		"293": "pipe2", "294": "inotify_init1", "295": "preadv", "296": "pwritev", "297": "rt_tgsigqueueinfo",
+		"298": "perf_event_open", "299": "recvmmsg", "300": "fanotify_init", "301": "fanotify_mark", "302": "prlimit64",
+		"303": "name_to_handle_at", "304": "open_by_handle_at", "305": "clock_adjtime", "306": "syncfs", "307": "sendmmsg",
+		"308": "setns", "309": "getcpu", "310": "process_vm_readv", "311": "process_vm_writev", "312": "kcmp",
+		"313": "finit_module", "314": "sched_setattr", "315": "sched_getattr", "316": "renameat2", "317": "seccomp",
+		"318": "getrandom", "319": "memfd_create", "320": "kexec_file_load", "321": "bpf", "322": "execveat",
+		"323": "userfaultfd", "324": "membarrier", "325": "mlock2", "326": "copy_file_range", "327": "preadv2",
+		"328": "pwritev2", "329": "pkey_mprotect", "330": "pkey_alloc", "331": "pkey_free", "332": "statx",
+		"333": "io_pgetevents", "334": "rseq", "424": "pidfd_send_signal", "425": "io_uring_setup", "426": "io_uring_enter",
+		"427": "io_uring_register", "428": "open_tree", "429": "move_mount", "430": "fsopen", "431": "fsconfig",
+		"432": "fsmount", "433": "fspick", "434": "pidfd_open", "435": "clone3", "436": "close_range", "437": "openat2",
+		"438": "pidfd_getfd", "439": "faccessat2", "440": "process_madvise", "441": "epoll_pwait2", "442": "mount_setattr",
+		"443": "quotactl_fd", "444": "landlock_create_ruleset", "445": "landlock_add_rule", "446": "landlock_restrict_self",
+		"447": "memfd_secret", "448": "process_mrelease", "449": "futex_waitv", "450": "set_mempolicy_home_node",
+	},
+	"aarch64": {
+		"0": "io_setup", "1": "io_destroy", "2": "io_submit", "3": "io_cancel", "4": "io_getevents", "5": "setxattr",
+		"6": "lsetxattr", "7": "fsetxattr", "8": "getxattr", "9": "lgetxattr", "10": "fgetxattr", "11": "listxattr",
+		"12": "llistxattr", "13": "flistxattr", "14": "removexattr", "15": "lremovexattr", "16": "fremovexattr", "17": "getcwd",
+		"18": "lookup_dcookie", "19": "eventfd2", "20": "epoll_create1", "21": "epoll_ctl", "22": "epoll_pwait", "23": "dup",
+		"24": "dup3", "25": "fcntl", "26": "inotify_init1", "27": "inotify_add_watch", "28": "inotify_rm_watch", "29": "ioctl",
+		"30": "ioprio_set", "31": "ioprio_get", "32": "flock", "33": "mknodat", "34": "mkdirat", "35": "unlinkat",
+		"36": "symlinkat", "37": "linkat", "38": "renameat", "39": "umount2", "40": "mount", "41": "pivot_root",
+		"42": "nfsservctl", "43": "statfs", "44": "fstatfs", "45": "truncate", "46": "ftruncate", "47": "fallocate",
+		"48": "faccessat", "49": "chdir", "50": "fchdir", "51": "chroot", "52": "fchmod", "53": "fchmodat", "54": "fchownat",
+		"55": "fchown", "56": "openat", "57": "close", "58": "vhangup", "59": "pipe2", "60": "quotactl", "61": "getdents64",
+		"62": "lseek", "63": "read", "64": "write", "65": "readv", "66": "writev", "67": "pread64", "68": "pwrite64",
+		"69": "preadv", "70": "pwritev", "71": "sendfile", "72": "pselect6", "73": "ppoll", "74": "signalfd4", "75": "vmsplice",
+		"76": "splice", "77": "tee", "78": "readlinkat", "79": "newfstatat", "80": "fstat", "81": "sync", "82": "fsync",
+		"83": "fdatasync", "84": "sync_file_range", "85": "timerfd_create", "86": "timerfd_settime", "87": "timerfd_gettime",
+		"88": "utimensat", "89": "acct", "90": "capget", "91": "capset", "92": "personality", "93": "exit", "94": "exit_group",
+		"95": "waitid", "96": "set_tid_address", "97": "unshare", "98": "futex", "99": "set_robust_list",
+		"100": "get_robust_list", "101": "nanosleep", "102": "getitimer", "103": "setitimer", "104": "kexec_load",
+		"105": "init_module", "106": "delete_module", "107": "timer_create", "108": "timer_gettime", "109": "timer_getoverrun",
+		"110": "timer_settime", "111": "timer_delete", "112": "clock_settime", "113": "clock_gettime", "114": "clock_getres",
+		"115": "clock_nanosleep", "116": "syslog", "117": "ptrace", "118": "sched_setparam", "119": "sched_setscheduler",
+		"120": "sched_getscheduler", "121": "sched_getparam", "122": "sched_setaffinity", "123": "sched_getaffinity",
+		"124": "sched_yield", "125": "sched_get_priority_max", "126": "sched_get_priority_min", "127": "sched_rr_get_interval",
+		"128": "restart_syscall", "129": "kill", "130": "tkill", "131": "tgkill", "132": "sigaltstack", "133": "rt_sigsuspend",
+		"134": "rt_sigaction", "135": "rt_sigprocmask", "136": "rt_sigpending", "137": "rt_sigtimedwait",
+		"138": "rt_sigqueueinfo", "139": "rt_sigreturn", "140": "setpriority", "141": "getpriority", "142": "reboot",
+		"143": "setregid", "144": "setgid", "145": "setreuid", "146": "setuid", "147": "setresuid", "148": "getresuid",
+		"149": "setresgid", "150": "getresgid", "151": "setfsuid", "152": "setfsgid", "153": "times", "154": "setpgid",
+		"155": "getpgid", "156": "getsid", "157": "setsid", "158": "getgroups", "159": "setgroups", "160": "uname",
+		"161": "sethostname", "162": "setdomainname", "163": "getrlimit", "164": "setrlimit", "165": "getrusage", "166": "umask",
+		"167": "prctl", "168": "getcpu", "169": "gettimeofday", "170": "settimeofday", "171": "adjtimex", "172": "getpid",
+		"173": "getppid", "174": "getuid", "175": "geteuid", "176": "getgid", "177": "getegid", "178": "gettid",
+		"179": "sysinfo", "180": "mq_open", "181": "mq_unlink", "182": "mq_timedsend", "183": "mq_timedreceive",
+		"184": "mq_notify", "185": "mq_getsetattr", "186": "msgget", "187": "msgctl", "188": "msgrcv", "189": "msgsnd",
+		"190": "semget", "191": "semctl", "192": "semtimedop", "193": "semop", "194": "shmget", "195": "shmctl", "196": "shmat",
+		"197": "shmdt", "198": "socket", "199": "socketpair", "200": "bind", "201": "listen", "202": "accept", "203": "connect",
+		"204": "getsockname", "205": "getpeername", "206": "sendto", "207": "recvfrom", "208": "setsockopt", "209": "getsockopt",
+		"210": "shutdown", "211": "sendmsg", "212": "recvmsg", "213": "readahead", "214": "brk", "215": "munmap",
+		"216": "mremap", "217": "add_key", "218": "request_key", "219": "keyctl", "220": "clone", "221": "execve", "222": "mmap",
+		"223": "fadvise64", "224": "swapon", "225": "swapoff", "226": "mprotect", "227": "msync", "228": "mlock",
+		"229": "munlock", "230": "mlockall", "231": "munlockall", "232": "mincore", "233": "madvise", "234": "remap_file_pages",
+		"235": "mbind", "236": "get_mempolicy", "237": "set_mempolicy", "238": "migrate_pages", "239": "move_pages",
+		"240": "rt_tgsigqueueinfo", "241": "perf_event_open", "242": "accept4", "243": "recvmmsg",
+		"244": "arch_specific_syscall", "260": "wait4", "261": "prlimit64", "262": "fanotify_init", "263": "fanotify_mark",
+		"264": "name_to_handle_at", "265": "open_by_handle_at", "266": "clock_adjtime", "267": "syncfs", "268": "setns",
+		"269": "sendmmsg", "270": "process_vm_readv", "271": "process_vm_writev", "272": "kcmp", "273": "finit_module",
+		"274": "sched_setattr", "275": "sched_getattr", "276": "renameat2", "277": "seccomp", "278": "getrandom",
+		"279": "memfd_create", "280": "bpf", "281": "execveat", "282": "userfaultfd", "283": "membarrier", "284": "mlock2",
+		"285": "copy_file_range", "286": "preadv2", "287": "pwritev2", "288": "pkey_mprotect", "289": "pkey_alloc",
+		"290": "pkey_free", "291": "statx", "292": "io_pgetevents", "293": "rseq", "294": "kexec_file_load",
+		"424": "pidfd_send_signal", "425": "io_uring_setup", "426": "io_uring_enter", "427": "io_uring_register",
+		"428": "open_tree", "429": "move_mount", "430": "fsopen", "431": "fsconfig", "432": "fsmount", "433": "fspick",
+		"434": "pidfd_open", "435": "clone3", "436": "close_range", "437": "openat2", "438": "pidfd_getfd", "439": "faccessat2",
+		"440": "process_madvise", "441": "epoll_pwait2", "442": "mount_setattr", "443": "quotactl_fd",
+		"444": "landlock_create_ruleset", "445": "landlock_add_rule", "446": "landlock_restrict_self", "447": "memfd_secret",
+		"448": "process_mrelease", "449": "futex_waitv", "450": "set_mempolicy_home_node",
+	},
+	"i386": {
+		"0": "restart_syscall", "1": "exit", "2": "fork", "3": "read", "4": "write", "5": "open", "6": "close", "7": "waitpid",
+		"8": "creat", "9": "link", "10": "unlink", "11": "execve", "12": "chdir", "13": "time", "14": "mknod", "15": "chmod",
+		"16": "lchown", "17": "break", "18": "oldstat", "19": "lseek", "20": "getpid", "21": "mount", "22": "umount",
+		"23": "setuid", "24": "getuid", "25": "stime", "26": "ptrace", "27": "alarm", "28": "oldfstat", "29": "pause",
+		"30": "utime", "31": "stty", "32": "gtty", "33": "access", "34": "nice", "35": "ftime", "36": "sync", "37": "kill",
+		"38": "rename", "39": "mkdir", "40": "rmdir", "41": "dup", "42": "pipe", "43": "times", "44": "prof", "45": "brk",
+		"46": "setgid", "47": "getgid", "48": "signal", "49": "geteuid", "50": "getegid", "51": "acct", "52": "umount2",
+		"53": "lock", "54": "ioctl", "55": "fcntl", "56": "mpx", "57": "setpgid", "58": "ulimit", "59": "oldolduname",
+		"60": "umask", "61": "chroot", "62": "ustat", "63": "dup2", "64": "getppid", "65": "getpgrp", "66": "setsid",
+		"67": "sigaction", "68": "sgetmask", "69": "ssetmask", "70": "setreuid", "71": "setregid", "72": "sigsuspend",
+		"73": "sigpending", "74": "sethostname", "75": "setrlimit", "76": "getrlimit", "77": "getrusage", "78": "gettimeofday",
+		"79": "settimeofday", "80": "getgroups", "81": "setgroups", "82": "select", "83": "symlink", "84": "oldlstat",
+		"85": "readlink", "86": "uselib", "87": "swapon", "88": "reboot", "89": "readdir", "90": "mmap", "91": "munmap",
+		"92": "truncate", "93": "ftruncate", "94": "fchmod", "95": "fchown", "96": "getpriority", "97": "setpriority",
+		"98": "profil", "99": "statfs", "100": "fstatfs", "101": "ioperm", "102": "socketcall", "103": "syslog",
+		"104": "setitimer", "105": "getitimer", "106": "stat", "107": "lstat", "108": "fstat", "109": "olduname", "110": "iopl",
+		"111": "vhangup", "112": "idle", "113": "vm86old", "114": "wait4", "115": "swapoff", "116": "sysinfo", "117": "ipc",
+		"118": "fsync", "119": "sigreturn", "120": "clone", "121": "setdomainname", "122": "uname", "123": "modify_ldt",
+		"124": "adjtimex", "125": "mprotect", "126": "sigprocmask", "127": "create_module", "128": "init_module",
+		"129": "delete_module", "130": "get_kernel_syms", "131": "quotactl", "132": "getpgid", "133": "fchdir", "134": "bdflush",
+		"135": "sysfs", "136": "personality", "137": "afs_syscall", "138": "setfsuid", "139": "setfsgid", "140": "_llseek",
+		"141": "getdents", "142": "_newselect", "143": "flock", "144": "msync", "145": "readv", "146": "writev", "147": "getsid",
+		"148": "fdatasync", "149": "_sysctl", "150": "mlock", "151": "munlock", "152": "mlockall", "153": "munlockall",
+		"154": "sched_setparam", "155": "sched_getparam", "156": "sched_setscheduler", "157": "sched_getscheduler",
+		"158": "sched_yield", "159": "sched_get_priority_max", "160": "sched_get_priority_min", "161": "sched_rr_get_interval",
+		"162": "nanosleep", "163": "mremap", "164": "setresuid", "165": "getresuid", "166": "vm86", "167": "query_module",
+		"168": "poll", "169": "nfsservctl", "170": "setresgid", "171": "getresgid", "172": "prctl", "173": "rt_sigreturn",
+		"174": "rt_sigaction", "175": "rt_sigprocmask", "176": "rt_sigpending", "177": "rt_sigtimedwait",
+		"178": "rt_sigqueueinfo", "179": "rt_sigsuspend", "180": "pread64", "181": "pwrite64", "182": "chown", "183": "getcwd",
+		"184": "capget", "185": "capset", "186": "sigaltstack", "187": "sendfile", "188": "getpmsg", "189": "putpmsg",
+		"190": "vfork", "191": "ugetrlimit", "192": "mmap2", "193": "truncate64", "194": "ftruncate64", "195": "stat64",
+		"196": "lstat64", "197": "fstat64", "198": "lchown32", "199": "getuid32", "200": "getgid32", "201": "geteuid32",
+		"202": "getegid32", "203": "setreuid32", "204": "setregid32", "205": "getgroups32", "206": "setgroups32",
+		"207": "fchown32", "208": "setresuid32", "209": "getresuid32", "210": "setresgid32", "211": "getresgid32",
+		"212": "chown32", "213": "setuid32", "214": "setgid32", "215": "setfsuid32", "216": "setfsgid32", "217": "pivot_root",
+		"218": "mincore", "219": "madvise", "220": "getdents64", "221": "fcntl64", "224": "gettid", "225": "readahead",
+		"226": "setxattr", "227": "lsetxattr", "228": "fsetxattr", "229": "getxattr", "230": "lgetxattr", "231": "fgetxattr",
+		"232": "listxattr", "233": "llistxattr", "234": "flistxattr", "235": "removexattr", "236": "lremovexattr",
+		"237": "fremovexattr", "238": "tkill", "239": "sendfile64", "240": "futex", "241": "sched_setaffinity",
+		"242": "sched_getaffinity", "243": "set_thread_area", "244": "get_thread_area", "245": "io_setup", "246": "io_destroy",
+		"247": "io_getevents", "248": "io_submit", "249": "io_cancel", "250": "fadvise64", "252": "exit_group",
+		"253": "lookup_dcookie", "254": "epoll_create", "255": "epoll_ctl", "256": "epoll_wait", "257": "remap_file_pages",
+		"258": "set_tid_address", "259": "timer_create", "260": "timer_settime", "261": "timer_gettime",
+		"262": "timer_getoverrun", "263": "timer_delete", "264": "clock_settime", "265": "clock_gettime", "266": "clock_getres",
+		"267": "clock_nanosleep", "268": "statfs64", "269": "fstatfs64", "270": "tgkill", "271": "utimes", "272": "fadvise64_64",
+		"273": "vserver", "274": "mbind", "275": "get_mempolicy", "276": "set_mempolicy", "277": "mq_open", "278": "mq_unlink",
+		"279": "mq_timedsend", "280": "mq_timedreceive", "281": "mq_notify", "282": "mq_getsetattr", "283": "kexec_load",
+		"284": "waitid", "286": "add_key", "287": "request_key", "288": "keyctl", "289": "ioprio_set", "290": "ioprio_get",
+		"291": "inotify_init", "292": "inotify_add_watch", "293": "inotify_rm_watch", "294": "migrate_pages", "295": "openat",
+		"296": "mkdirat", "297": "mknodat", "298": "fchownat", "299": "futimesat", "300": "fstatat64", "301": "unlinkat",
+		"302": "renameat", "303": "linkat", "304": "symlinkat", "305": "readlinkat", "306": "fchmodat", "307": "faccessat",
+		"308": "pselect6", "309": "ppoll", "310": "unshare", "311": "set_robust_list", "312": "get_robust_list", "313": "splice",
+		"314": "sync_file_range", "315": "tee", "316": "vmsplice", "317": "move_pages", "318": "getcpu", "319": "epoll_pwait",
+		"320": "utimensat", "321": "signalfd", "322": "timerfd_create", "323": "eventfd", "324": "fallocate",
+		"325": "timerfd_settime", "326": "timerfd_gettime", "327": "signalfd4", "328": "eventfd2", "329": "epoll_create1",
+		"330": "dup3", "331": "pipe2", "332": "inotify_init1", "333": "preadv", "334": "pwritev", "335": "rt_tgsigqueueinfo",
+		"336": "perf_event_open", "337": "recvmmsg", "338": "fanotify_init", "339": "fanotify_mark", "340": "prlimit64",
+		"341": "name_to_handle_at", "342": "open_by_handle_at", "343": "clock_adjtime", "344": "syncfs", "345": "sendmmsg",
+		"346": "setns", "347": "process_vm_readv", "348": "process_vm_writev", "349": "kcmp", "350": "finit_module",
+		"351": "sched_setattr", "352": "sched_getattr", "353": "renameat2", "354": "seccomp", "355": "getrandom",
+		"356": "memfd_create", "357": "bpf", "358": "execveat", "359": "socket", "360": "socketpair", "361": "bind",
+		"362": "connect", "363": "listen", "364": "accept4", "365": "getsockopt", "366": "setsockopt", "367": "getsockname",
+		"368": "getpeername", "369": "sendto", "370": "sendmsg", "371": "recvfrom", "372": "recvmsg", "373": "shutdown",
+		"374": "userfaultfd", "375": "membarrier", "376": "mlock2", "377": "copy_file_range", "378": "preadv2",
+		"379": "pwritev2", "380": "pkey_mprotect", "381": "pkey_alloc", "382": "pkey_free", "383": "statx", "384": "arch_prctl",
+		"385": "io_pgetevents", "386": "rseq", "393": "semget", "394": "semctl", "395": "shmget", "396": "shmctl",
+		"397": "shmat", "398": "shmdt", "399": "msgget", "400": "msgsnd", "401": "msgrcv", "402": "msgctl",
+		"403": "clock_gettime64", "404": "clock_settime64", "405": "clock_adjtime64", "406": "clock_getres_time64",
+		"407": "clock_nanosleep_time64", "408": "timer_gettime64", "409": "timer_settime64", "410": "timerfd_gettime64",
+		"411": "timerfd_settime64", "412": "utimensat_time64", "413": "pselect6_time64", "414": "ppoll_time64",
+		"416": "io_pgetevents_time64", "417": "recvmmsg_time64", "418": "mq_timedsend_time64", "419": "mq_timedreceive_time64",
+		"420": "semtimedop_time64", "421": "rt_sigtimedwait_time64", "422": "futex_time64",
+		"423": "sched_rr_get_interval_time64", "424": "pidfd_send_signal", "425": "io_uring_setup", "426": "io_uring_enter",
+		"427": "io_uring_register", "428": "open_tree", "429": "move_mount", "430": "fsopen", "431": "fsconfig",
+		"432": "fsmount", "433": "fspick", "434": "pidfd_open", "435": "clone3", "436": "close_range", "437": "openat2",
+		"438": "pidfd_getfd", "439": "faccessat2", "440": "process_madvise", "441": "epoll_pwait2", "442": "mount_setattr",
+		"443": "quotactl_fd", "444": "landlock_create_ruleset", "445": "landlock_add_rule", "446": "landlock_restrict_self",
+		"447": "memfd_secret", "448": "process_mrelease", "449": "futex_waitv", "450": "set_mempolicy_home_node",
+	},
+}
+
+// Группы списка журнала аудита: поля событий и сводки в стиле aureport (сводки и частота выводятся по убыванию количества событий)
+var auditGroups = []struct {
+	name    string
+	byCount bool
+}{
+	{"failed-login", true}, // неудачные попытки входа (aureport --login --failed)
+	{"auth", true},         // события аутентификации (aureport --auth)
+	{"anomaly", true},      // события аномалий (aureport --anomaly)
+	{"denial", true},       // запреты SELinux и AppArmor (aureport --avc)
+	{"key", false},
+	{"type", false},
+	{"uid", false},
+	{"exe", true},     // частота запуска исполняемых файлов (aureport --executable)
+	{"syscall", true}, // частота системных вызовов (aureport --syscall)
+}
+
+// Функция для получения первого значения поля в записях события
+func (e auditEvent) field(name string) string {
+	for _, record := range e.records {
+		for _, field := range record.fields {
+			if field.name == name {
+				return field.value
+			}
+		}
 	}
+	return ""
 }
 
-// Функция для перемещения по списку журналов вниз
-func (app *App) nextService(v *gocui.View, step int) error {
-	// Обновляем текущее количество видимых строк в терминале (-1 заголовок)
-	_, viewHeight := v.Size()
-	app.maxVisibleServices = viewHeight
-	// Если список журналов пустой, ничего не делаем
-	if len(app.journals) == 0 {
-		return nil
+// Функция для получения значений поля события, по которому формируется список (ключи правил разделяются символом \x01)
+func (e auditEvent) groupValues(name string) []string {
+	var values []string
+	switch name {
+	case "type":
+		for _, record := range e.records {
+			if !slices.Contains(values, record.recordType) {
+				values = append(values, record.recordType)
+			}
+		}
+	case "key":
+		for key := range strings.SplitSeq(e.field("key"), "\x01") {
+			if key != "" && key != "(null)" {
+				values = append(values, key)
+			}
+		}
+	case "syscall":
+		if e.hasType("SYSCALL") {
+			values = append(values, e.field("syscall"))
+		}
+	case "failed-login":
+		if e.hasType("USER_LOGIN") && e.failed() {
+			values = append(values, e.account()+" from "+e.address())
+		}
+	case "auth":
+		if e.hasType("USER_AUTH") {
+			values = append(values, e.account()+" "+e.field("res")+" from "+e.address())
+		}
+	case "anomaly":
+		for _, record := range e.records {
+			if strings.HasPrefix(record.recordType, "ANOM_") {
+				values = append(values, record.recordType+" "+cmp.Or(e.field("exe"), e.field("comm"), "?"))
+			}
+		}
+	case "denial":
+		if e.denied() {
+			profile := cmp.Or(e.field("profile"), e.field("scontext"), "?")
+			path := cmp.Or(e.field("path"), e.field("name"), "?")
+			perms := cmp.Or(e.field("seperms"), e.field("denied_mask"), e.field("requested_mask"), "?")
+			values = append(values, profile+" "+path+" {"+perms+"}")
+		}
+	default:
+		if value := e.field(name); value != "" && value != "?" && value != "(null)" && value != "unset" {
+			values = append(values, value)
+		}
 	}
-	// Переходим к следующему, если текущий выбранный журнал не последний
-	if app.selectedJournal < len(app.journals)-1 {
-		// Увеличиваем индекс выбранного журнала
-		app.selectedJournal += step
-		// Проверяем, чтобы не выйти за пределы списка
-		if app.selectedJournal >= len(app.journals) {
-			app.selectedJournal = len(app.journals) - 1
+	return values
+}
+
+// Функция для проверки наличия записи указанного типа в событии
+func (e auditEvent) hasType(recordType string) bool {
+	return slices.ContainsFunc(e.records, func(record auditRecord) bool {
+		return record.recordType == recordType
+	})
+}
+
+// Функция для получения учетной записи события входа или аутентификации
+func (e auditEvent) account() string {
+	return cmp.Or(e.field("acct"), e.field("id"), e.field("auid"), "?")
+}
+
+// Функция для получения адреса, с которого выполнен вход (адрес, имя хоста или терминал)
+func (e auditEvent) address() string {
+	for _, name := range []string{"addr", "hostname", "terminal"} {
+		if value := e.field(name); value != "" && value != "?" {
+			return value
 		}
-		// Проверяем, вышли ли за пределы видимой области (увеличиваем стартовую позицию видимости, только если дошли до 0 + maxVisibleServices)
-		if app.selectedJournal >= app.startServices+app.maxVisibleServices {
-			// Сдвигаем видимую область вниз
-			app.startServices += step
-			// Проверяем, чтобы не выйти за пределы списка
-			if app.startServices > len(app.journals)-app.maxVisibleServices {
-				app.startServices = len(app.journals) - app.maxVisibleServices
+	}
+	return "?"
+}
+
+// Функция для проверки, что событие содержит запрет SELinux (avc: denied) или AppArmor (apparmor="DENIED")
+func (e auditEvent) denied() bool {
+	for _, record := range e.records {
+		switch record.recordType {
+		case "AVC", "USER_AVC", "APPARMOR_DENIED":
+		default:
+			continue
+		}
+		for _, field := range record.fields {
+			if (field.name == "seresult" && field.value == "denied") || (field.name == "apparmor" && field.value == "DENIED") {
+				return true
 			}
-			// Обновляем отображение списка служб
-			app.updateServicesList()
 		}
-		// Если сдвинули видимую область, корректируем индекс для смещения курсора в интерфейсе
-		if app.selectedJournal < app.startServices+app.maxVisibleServices {
-			// Выбираем журнал по скорректированному индексу
-			return app.selectServiceByIndex(app.selectedJournal - app.startServices)
+		if record.recordType == "APPARMOR_DENIED" {
+			return true
 		}
 	}
-	return nil
+	return false
 }
 
-// Функция для перемещения по списку журналов вверх
-func (app *App) prevService(v *gocui.View, step int) error {
-	_, viewHeight := v.Size()
-	app.maxVisibleServices = viewHeight
-	if len(app.journals) == 0 {
-		return nil
+// Функция для проверки, что событие завершилось ошибкой (системный вызов или операция пользователя)
+func (e auditEvent) failed() bool {
+	return e.field("success") == "no" || e.field("res") == "failed" || e.field("res") == "0"
+}
+
+// Функция для разбора строк журнала аудита в события (записи группируются по времени и порядковому номеру)
+func parseAuditLog(lines []string, users map[string]string) []auditEvent {
+	return appendAuditLog(nil, make(map[string]int), lines, users)
+}
+
+// Функция для добавления событий из строк журнала аудита к уже разобранным (index содержит позиции событий по времени и номеру)
+func appendAuditLog(events []auditEvent, index map[string]int, lines []string, users map[string]string) []auditEvent {
+	for _, line := range lines {
+		match := auditRecordRegex.FindStringSubmatch(strings.TrimSpace(line))
+		if match == nil {
+			continue
+		}
+		id := match[3] + "." + match[4] + ":" + match[5]
+		i, found := index[id]
+		if !found {
+			sec, _ := strconv.ParseInt(match[3], 10, 64)
+			msec, _ := strconv.ParseInt(match[4], 10, 64)
+			events = append(events, auditEvent{
+				id:        id,
+				serial:    match[5],
+				timestamp: time.Unix(sec, msec*int64(time.Millisecond)),
+				node:      match[1],
+			})
+			i = len(events) - 1
+			index[id] = i
+		}
+		events[i].records = append(events[i].records, auditRecord{
+			recordType: match[2],
+			fields:     parseAuditFields(match[6], users),
+		})
 	}
-	// Переходим к предыдущему, если текущий выбранный журнал не первый
-	if app.selectedJournal > 0 {
-		app.selectedJournal -= step
-		// Если ушли в минус (за начало журнала), приводим к нулю
-		if app.selectedJournal < 0 {
-			app.selectedJournal = 0
+	return events
+}
+
+// Функция для разбора полей записи аудита с декодированием hex значений и интерпретацией идентификаторов
+func parseAuditFields(text string, users map[string]string) []auditField {
+	// В формате ENRICHED интерпретированные значения передаются после разделителя \x1d (ARCH=x86_64 SYSCALL=execve UID="root")
+	raw, enriched, _ := strings.Cut(text, "\x1d")
+	interpreted := make(map[string]string)
+	for _, field := range splitAuditFields(enriched) {
+		interpreted[strings.ToLower(field.name)] = field.value
+	}
+	fields := splitAuditFields(raw)
+	var arch string
+	for i, field := range fields {
+		value, found := interpreted[field.name]
+		switch {
+		case found:
+		case field.name == "arch":
+			if name, ok := auditArchNames[field.value]; ok {
+				value = name
+			}
+		case field.name == "syscall":
+			value = auditSyscallNames[arch][field.value]
+		case slices.Contains(auditUidFields, field.name):
+			switch field.value {
+			case "4294967295", "-1":
+				value = "unset"
+			default:
+				value = users[field.value]
+			}
 		}
-		// Проверяем, вышли ли за пределы видимой области
-		if app.selectedJournal < app.startServices {
-			app.startServices -= step
-			if app.startServices < 0 {
-				app.startServices = 0
+		if value != "" {
+			fields[i].value = value
+		}
+		if field.name == "arch" {
+			arch = fields[i].value
+		}
+	}
+	return fields
+}
+
+// Функция для разделения текста записи аудита на поля (вложенные поля msg='...' добавляются в общий список)
+func splitAuditFields(text string) []auditField {
+	var fields []auditField
+	for text != "" {
+		text = strings.TrimLeft(text, " ")
+		// Результат и разрешения SELinux передаются без названия поля (avc:  denied  { read write } for ...)
+		if perms, found := strings.CutPrefix(text, "{"); found {
+			perms, text, _ = strings.Cut(perms, "}")
+			fields = append(fields, auditField{name: "seperms", value: strings.TrimSpace(perms)})
+			continue
+		}
+		name, rest, found := strings.Cut(text, "=")
+		if !found || strings.Contains(name, " ") {
+			// Пропускаем слово без значения
+			var word string
+			word, text, _ = strings.Cut(text, " ")
+			if word == "denied" || word == "granted" {
+				fields = append(fields, auditField{name: "seresult", value: word})
 			}
-			app.updateServicesList()
+			continue
 		}
-		if app.selectedJournal >= app.startServices {
-			return app.selectServiceByIndex(app.selectedJournal - app.startServices)
+		var value string
+		switch {
+		case strings.HasPrefix(rest, `"`):
+			value, text, _ = strings.Cut(rest[1:], `"`)
+		case strings.HasPrefix(rest, "'"):
+			value, text, _ = strings.Cut(rest[1:], "'")
+			fields = append(fields, splitAuditFields(value)...)
+			continue
+		default:
+			value, text, _ = strings.Cut(rest, " ")
+			if slices.Contains(auditHexFields, name) {
+				value = decodeAuditHex(name, value)
+			}
 		}
+		fields = append(fields, auditField{name: name, value: value})
 	}
-	return nil
+	return fields
 }
 
-// Функция для визуального выбора журнала по индексу (смещение курсора выделения)
-func (app *App) selectServiceByIndex(index int) error {
-	// Получаем доступ к представлению списка служб
-	v, err := app.gui.View("services")
-	if err != nil {
-		return err
+// Функция для декодирования hex значения поля (аргументы proctitle разделены нулевым байтом)
+func decodeAuditHex(name, value string) string {
+	if len(value) < 2 || len(value)%2 != 0 || strings.Trim(value, "0123456789ABCDEF") != "" {
+		return value
 	}
-	// Обновляем счетчик в заголовке
-	re := regexp.MustCompile(`\s\(.+\) >`)
-	updateTitle := " (0) >"
-	if len(app.journals) != 0 {
-		updateTitle = " (" + strconv.Itoa(app.selectedJournal+1) + "/" + strconv.Itoa(len(app.journals)) + ") >"
+	data := make([]byte, len(value)/2)
+	for i := range data {
+		b, err := strconv.ParseUint(value[i*2:i*2+2], 16, 8)
+		if err != nil {
+			return value
+		}
+		data[i] = byte(b)
 	}
-	v.Title = re.ReplaceAllString(v.Title, updateTitle)
-	// Устанавливаем курсор на нужный индекс (строку)
-	// Первый столбец (0), индекс строки
-	if err := v.SetCursor(0, index); err != nil {
-		return nil
+	if name == "proctitle" {
+		return strings.TrimSpace(strings.ReplaceAll(string(data), "\x00", " "))
 	}
-	return nil
+	return string(data)
 }
 
-// Функция для выбора журнала в списке сервисов по нажатию Enter
-func (app *App) selectService(g *gocui.Gui, v *gocui.View) error {
-	// Проверка, что есть доступ к представлению и список журналов не пустой
-	if v == nil || len(app.journals) == 0 {
-		return nil
+// Функция для формирования списка журнала аудита со сводками и группами событий по полям
+func auditEventGroups(events []auditEvent) []Journal {
+	var journals []Journal
+	for _, group := range auditGroups {
+		counts := make(map[string]int)
+		for _, event := range events {
+			for _, value := range event.groupValues(group.name) {
+				counts[value]++
+			}
+		}
+		values := slices.Sorted(maps.Keys(counts))
+		if group.byCount {
+			slices.SortStableFunc(values, func(a, b string) int {
+				return counts[b] - counts[a]
+			})
+		}
+		for _, value := range values {
+			journals = append(journals, Journal{
+				name:    fmt.Sprintf("[\033[36m%s\033[0m] %s (%d)", group.name, value, counts[value]),
+				boot_id: group.name + "=" + value,
+			})
+		}
 	}
-	// Получаем текущую позицию курсора
-	_, cy := v.Cursor()
-	// Читаем строку, на которой находится курсор
-	line, err := v.Line(cy)
-	if err != nil {
-		return err
+	return journals
+}
+
+// Функция для отбора событий по полю и значению из списка журнала аудита
+func filterAuditEvents(events []auditEvent, groupId string) []auditEvent {
+	name, value, _ := strings.Cut(groupId, "=")
+	return slices.DeleteFunc(slices.Clone(events), func(event auditEvent) bool {
+		return !slices.Contains(event.groupValues(name), value)
+	})
+}
+
+// Функция для формирования записей вывода события аудита (заголовок с полями события и записи с отступом)
+func auditEventRecords(event auditEvent) []LogRecord {
+	types := event.groupValues("type")
+	header := LogRecord{
+		format:    plainRecord,
+		timestamp: event.timestamp,
+		timeText:  event.timestamp.Format("Jan 02 15:04:05"),
+		source:    strings.Join(types, ","),
+		fields:    map[string]string{"serial": event.serial, "type": strings.Join(types, ",")},
+	}
+	if event.node != "" {
+		header.fields["node"] = event.node
+	}
+	summary := []string{"audit(" + event.id + ")", strings.Join(types, ",")}
+	for _, name := range []string{"key", "uid", "exe"} {
+		if values := event.groupValues(name); len(values) > 0 {
+			summary = append(summary, name+"="+strings.Join(values, ","))
+		}
+	}
+	if event.failed() {
+		header.level = "err"
+		summary = append(summary, "failed")
+	}
+	header.message = strings.Join(summary, " ")
+	records := []LogRecord{header}
+	seen := make(map[string]int)
+	for _, record := range event.records {
+		// Поля повторяющихся записей (например, PATH) сохраняются с номером записи
+		prefix := record.recordType
+		if n := seen[record.recordType]; n > 0 {
+			prefix += "[" + strconv.Itoa(n) + "]"
+		}
+		seen[record.recordType]++
+		line := []string{"type=" + record.recordType}
+		for _, field := range record.fields {
+			header.fields[prefix+"."+field.name] = field.value
+			value := field.value
+			if strings.ContainsAny(value, " \t") {
+				value = strconv.Quote(value)
+			}
+			line = append(line, field.name+"="+value)
+		}
+		records = append(records, LogRecord{
+			format:    plainRecord,
+			timestamp: event.timestamp,
+			level:     header.level,
+			message:   "    " + strings.Join(line, " "),
+		})
 	}
-	// Загружаем журналы выбранной службы, обрезая пробелы в названии
-	if app.fastMode {
-		go func() {
-			app.loadJournalLogs(strings.TrimSpace(line), true)
-		}()
-	} else {
-		app.loadJournalLogs(strings.TrimSpace(line), true)
+	return records
+}
+
+// Функция для получения имен пользователей по uid из /etc/passwd
+func parsePasswd(data string) map[string]string {
+	users := make(map[string]string)
+	for line := range strings.SplitSeq(data, "\n") {
+		parts := strings.Split(line, ":")
+		if len(parts) >= 3 && !strings.HasPrefix(parts[0], "#") {
+			users[parts[2]] = parts[0]
+		}
 	}
-	// Включаем загрузку журнала (только при ручном выборе для Windows)
-	app.updateFile = true
-	// Фиксируем для ручного или автоматического обновления вывода журнала
-	app.lastWindow = "services"
-	app.lastSelected = strings.TrimSpace(line)
-	return nil
+	return users
 }
 
-// Функция для загрузки записей журнала выбранной службы через journalctl
-// Второй параметр для обнолвения позиции делимитра нового вывода лога а также сброса автоскролл
-func (app *App) loadJournalLogs(serviceName string, newUpdate bool) {
-	// Сбрасываем последнюю используемую систему контейнеризации (ошибка при покраске после compose)
-	app.lastContainerizationSystem = ""
-	if serviceName == "" {
-		return
+// Функция для получения пути к журналу аудита
+func (app *App) auditLog() string {
+	if app.auditLogPath == "" {
+		return auditLogDefaultPath
 	}
-	app.debugStartTime = time.Now()
-	var output []byte
-	var err error
-	selectUnits := app.selectUnits
-	if newUpdate {
-		app.lastSelectUnits = app.selectUnits
+	return app.auditLogPath
+}
+
+// Функция для получения файлов журнала аудита в порядке записи (ротированные файлы audit.log.N от старых к новым)
+func (app *App) auditLogFiles(ctx context.Context) ([]string, error) {
+	dir, base := filepath.Split(app.auditLog())
+	var names []string
+	if app.sshMode {
+		output, err := app.runCommandContext(ctx, 0, "Loading the audit log files", "ls", "-1", dir)
+		if err != nil {
+			return nil, err
+		}
+		names = strings.Split(string(output), "\n")
 	} else {
-		selectUnits = app.lastSelectUnits
-	}
-	// Обновляем статус с названием источника журнала (название юнита)
-	if !app.testMode {
-		v, err := app.gui.View("logs")
-		serviceWithoutStatus := serviceName
-		serviceNameSplit := strings.Split(serviceWithoutStatus, "] ")
-		if len(serviceNameSplit) == 2 && len(serviceNameSplit[1]) > 0 {
-			serviceWithoutStatus = serviceNameSplit[1]
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			return nil, err
 		}
-		if err == nil {
-			v.Subtitle = "[ " + selectUnits + "/" + serviceWithoutStatus + " ]"
+		for _, entry := range entries {
+			names = append(names, entry.Name())
 		}
 	}
-	switch {
-	// Читаем журналы Windows
-	case app.getOS == "windows":
-		if !app.updateFile {
-			return
-		}
-		// Отключаем чтение в горутине
-		app.updateFile = false
-		// Извлекаем полное имя события
-		var eventName string
-		for _, journal := range app.journals {
-			journalBootName := removeANSI(journal.name)
-			if journalBootName == serviceName {
-				eventName = journal.boot_id
-				break
+	rotated := make(map[string]int)
+	for _, name := range names {
+		if suffix, found := strings.CutPrefix(strings.TrimSpace(name), base+"."); found {
+			if n, err := strconv.Atoi(suffix); err == nil {
+				rotated[name] = n
 			}
 		}
-		output = app.loadWinEventLog(eventName)
-		if len(output) == 0 && !app.testMode {
-			v, _ := app.gui.View("logs")
-			v.Clear()
-			return
-		}
-		if len(output) == 0 && app.testMode {
-			app.currentLogLines = []string{}
-			return
-		}
-	// Читаем лог выбранного по ключу журнала аудита
-	case selectUnits == "auditd":
-		if newUpdate {
-			app.lastBootId = serviceName
-		} else {
-			serviceName = app.lastBootId
-		}
-		var cmd *exec.Cmd
-		if app.sshMode {
-			cmd = exec.Command(
-				"ssh", append(app.sshOptions,
-					"ausearch", "-k", serviceName, "--format", "interpret",
-				)...)
-		} else {
-			cmd = exec.Command(
-				"ausearch", "-k", serviceName, "--format", "interpret",
-			)
-		}
-		if app.logging {
-			slog.Info(cmd.String(), "action", "Reading logs from audit rules keys")
-		}
-		output, err = cmd.Output()
-		if err != nil && !app.testMode {
-			v, _ := app.gui.View("logs")
-			v.Clear()
-			fmt.Fprintln(v, "\033[31mError getting auditd logs:", err, "\033[0m")
-			return
-		}
-		if err != nil && app.testMode {
-			log.Print("Error: getting auditd logs. ", err)
-		}
-	// Читаем лог ядра загрузки системы
-	case selectUnits == "kernelBoot":
-		// Извлекаем id журнала из названия
-		var boot_id string
-		for _, journal := range app.journals {
-			journalBootName := removeANSI(journal.name)
-			if journalBootName == serviceName {
-				boot_id = journal.boot_id
-				break
-			}
-		}
-		// Сохраняем название для обновления вывода журнала при фильтрации списков
-		if newUpdate {
-			app.lastBootId = boot_id
-		} else {
-			boot_id = app.lastBootId
-		}
-		var cmd *exec.Cmd
-		if app.sshMode {
-			cmd = exec.Command(
-				"ssh", append(app.sshOptions,
-					"journalctl", "-k", "-b", boot_id, "--no-pager", "-n", app.logViewCount,
-				)...)
-		} else {
-			cmd = exec.Command(
-				"journalctl", "-k", "-b", boot_id, "--no-pager", "-n", app.logViewCount,
-			)
-		}
-		if app.logging {
-			slog.Info(cmd.String(), "action", "Reading logs from kernel boot")
-		}
-		output, err = cmd.Output()
-		if err != nil && !app.testMode {
-			v, _ := app.gui.View("logs")
-			v.Clear()
-			fmt.Fprintln(v, "\033[31mError getting kernal logs:", err, "\033[0m")
-			return
-		}
-		if err != nil && app.testMode {
-			log.Print("Error: getting kernal logs. ", err)
-		}
-	// Загрузка журналов для юнитов systemd (--unit=UNIT) и системных журналов с фильтрацией (--field=FIELD)
-	default:
-		// Удаляем статусы сервисов из навзания
-		serviceNameNew := strings.Split(serviceName, "] ")
-		if len(serviceNameNew) >= 2 {
-			serviceName = serviceNameNew[1]
-		}
-		var cmd *exec.Cmd
-		// #34 Используем массив для формирования аргументов команды
-		var args []string
-		// #46 Добавляем аргумент для пользовательских журналов
-		if app.selectUnits == "userUnits" {
-			args = append(args, "--user")
-		}
-		// Фильтрация по юниту (unit)
-		if serviceName != "_all" && selectUnits != "systemJournals" {
-			args = append(args, "--unit="+serviceName)
-		}
-		// Фильтрация по полю (field)
-		if serviceName != "_all" && selectUnits == "systemJournals" {
-			args = append(args, app.journalField+"="+serviceName)
-		}
-		// Фильтрация по порядковому номеру загрузки системы (boot)
-		args = append(args, "--boot="+app.journalBoot)
-		// Фильтрация по приоритету
-		args = append(args, "--priority="+app.journalPriority)
-		// Добавляем базовые аргументы
-		args = append(args, "--no-pager")
-		args = append(args, "--lines="+app.logViewCount)
-		// Добавляем аргументы для фильтрации по времени
-		if app.sinceDateFilterMode {
-			args = append(args, "--since", app.sinceFilterText)
-		}
-		if app.untilDateFilterMode {
-			args = append(args, "--until", app.untilFilterText)
-		}
-		// Проверяем режим работы и формируем команду для выполнения
-		if app.sshMode {
-			// Создаем копию массива с заранее определенной емкостью
-			sshArgs := make([]string, 0, len(app.sshOptions)+1+len(args))
-			sshArgs = append(sshArgs, app.sshOptions...)
-			sshArgs = append(sshArgs, "journalctl")
-			sshArgs = append(sshArgs, args...)
-			cmd = exec.Command("ssh", sshArgs...)
-		} else {
-			cmd = exec.Command("journalctl", args...)
-		}
-		if app.logging {
-			var logSource string
-			switch app.selectUnits {
-			case "systemUnits":
-				logSource = "Reading logs from system units"
-			case "userUnits":
-				logSource = "Reading logs from user units"
-			default:
-				logSource = "Reading logs from system journals"
-			}
-			slog.Info(cmd.String(), "action", logSource)
-		}
-		output, err = cmd.Output()
-		if err != nil && !app.testMode {
-			v, _ := app.gui.View("logs")
-			v.Clear()
-			fmt.Fprintln(v, "\033[31mError getting journald logs:", err, "\033[0m")
-			return
-		}
-		if err != nil && app.testMode {
-			log.Print("Error: getting journald logs.", err)
-		}
-	}
-	// Сохраняем строки журнала в массив
-	app.currentLogLines = strings.Split(string(output), "\n")
-	if !app.testMode {
-		app.updateDelimiter(newUpdate)
-		// Очищаем поле ввода для фильтрации, что бы не применять фильтрацию к новому журналу
-		// app.filterText = ""
-		// Применяем текущий фильтр к записям для обновления вывода
-		app.applyFilter(false)
-	}
-}
-
-// Функция для чтения и парсинга содержимого события Windows через wevtutil
-func (app *App) loadWinEventLog(eventName string) (output []byte) {
-	app.lastContainerizationSystem = ""
-	if eventName == "" {
-		return []byte("")
 	}
-	cmd := exec.Command("powershell", "-Command",
-		"wevtutil qe "+eventName+" /f:text -l:en /c:"+app.logViewCount+
-			" /q:'*[System[TimeCreated[timediff(@SystemTime) <= 2592000000]]]'")
-	if app.logging {
-		slog.Info(cmd.String(), "action", "Reading logs from windows event")
+	files := slices.SortedFunc(maps.Keys(rotated), func(a, b string) int {
+		return rotated[b] - rotated[a]
+	})
+	for i := range files {
+		files[i] = dir + files[i]
 	}
-	eventData, _ := cmd.Output()
-	// Декодирование вывода из Windows-1251 в UTF-8
-	decoder := charmap.Windows1251.NewDecoder()
-	decodeEventData, decodeErr := decoder.Bytes(eventData)
-	if decodeErr == nil {
-		eventData = decodeEventData
+	if slices.Contains(names, base) {
+		files = append(files, dir+base)
 	}
-	// Разбиваем вывод на массив
-	eventStrings := strings.Split(string(eventData), "Event[")
-	var eventMessage []string
-	for _, eventString := range eventStrings {
-		var dateTime, eventID, level, description string
-		// Разбиваем элемент массива на строки
-		lines := strings.Split(eventString, "\n")
-		// Флаг для обработки последней строки Description с содержимым Message
-		isDescription := false
-		for _, line := range lines {
-			// Удаляем проблемы во всех строках
-			trimmedLine := strings.TrimSpace(line)
-			switch {
-			// Обновляем формат даты
-			case strings.HasPrefix(trimmedLine, "Date:"):
-				dateTime = strings.ReplaceAll(trimmedLine, "Date: ", "")
-				dateTimeParse := strings.Split(dateTime, "T")
-				dateParse := strings.Split(dateTimeParse[0], "-")
-				timeParse := strings.Split(dateTimeParse[1], ".")
-				dateTime = fmt.Sprintf("%s.%s.%s %s", dateParse[2], dateParse[1], dateParse[0], timeParse[0])
-			case strings.HasPrefix(trimmedLine, "Event ID:"):
-				eventID = strings.ReplaceAll(trimmedLine, "Event ID: ", "")
-			case strings.HasPrefix(trimmedLine, "Level:"):
-				level = strings.ReplaceAll(trimmedLine, "Level: ", "")
-			case strings.HasPrefix(trimmedLine, "Description:"):
-				// Фиксируем и пропускаем Description
-				isDescription = true
-			case isDescription:
-				// Добавляем до конца текущего массива все не пустые строки
-				if trimmedLine != "" {
-					description += "\n" + trimmedLine
-				}
-			}
-		}
-		if dateTime != "" && eventID != "" && level != "" && description != "" {
-			eventMessage = append(eventMessage, fmt.Sprintf("%s %s (%s): %s", dateTime, level, eventID, strings.TrimSpace(description)))
-		}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("%s not found", app.auditLog())
 	}
-	fullMessage := strings.Join(eventMessage, "\n")
-	return []byte(fullMessage)
+	return files, nil
 }
 
-// ---------------------------------------- Filesystem ----------------------------------------
-
-// Базовая структура os.Stat
-type fileInfo struct {
-	name    string
-	size    int64
-	modTime time.Time
+// Разобранные события журнала аудита (при обновлении читаются только строки, дописанные в текущий файл)
+type auditCache struct {
+	mu      sync.Mutex
+	path    string      // текущий файл журнала
+	info    os.FileInfo // файл, из которого прочитаны события (локально)
+	inode   string      // inode файла, из которого прочитаны события (по ssh)
+	offset  int64       // размер прочитанной части текущего файла
+	partial string      // последняя неполная строка до записи символа переноса
+	events  []auditEvent
+	index   map[string]int // позиции событий по времени и номеру для добавления записей
+	users   map[string]string
 }
 
-// Дочерние методы os.Stat
-func (fi *fileInfo) Name() string       { return fi.name }
-func (fi *fileInfo) Size() int64        { return fi.size }
-func (fi *fileInfo) ModTime() time.Time { return fi.modTime }
-func (fi *fileInfo) Mode() os.FileMode  { return 0o644 } // default rights
-func (fi *fileInfo) IsDir() bool        { return false } // only file
-func (fi *fileInfo) Sys() any           { return nil }
+// Функция для чтения и разбора событий из журнала аудита и его ротированных файлов
+func (app *App) loadAuditEvents(ctx context.Context) ([]auditEvent, error) {
+	if app.auditCache == nil {
+		app.auditCache = &auditCache{}
+	}
+	cache := app.auditCache
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	path := app.auditLog()
+	// Кэш собирается заново при первом чтении, смене пути и ротации или усечении текущего файла
+	appended, same, err := app.readAuditAppended(ctx, cache, path)
+	if err != nil {
+		return nil, err
+	}
+	if !same {
+		if err := app.readAuditFiles(ctx, cache, path); err != nil {
+			return nil, err
+		}
+	} else {
+		cache.offset += int64(len(appended))
+		cache.appendData(appended)
+	}
+	return slices.Clone(cache.events), nil
+}
 
-// Имитация метода os.Stat через exec.Command
-func (app *App) statFile(path string) (os.FileInfo, error) {
+// Функция для чтения байтов, дописанных в текущий файл журнала аудита после последнего чтения (same=false, если файл заменен)
+func (app *App) readAuditAppended(ctx context.Context, cache *auditCache, path string) (data []byte, same bool, err error) {
+	if cache.path != path || cache.index == nil {
+		return nil, false, nil
+	}
 	if app.sshMode {
-		// Аргументы для команды stats. Ключи для перехода по символическим ссылкам
-		// для получения информации о целевых файлах (для проверки доступа) и форматирования вывода
-		statArgs := app.sshOptions
-		statArgs = append(statArgs, "stat", "-L", "-c", "'%n|%s|%Y'", path)
-		cmd := exec.Command("ssh", statArgs...)
-		if app.logging {
-			slog.Info(cmd.String(), "action", "Loading the log files")
-		}
-		output, err := cmd.Output()
+		// Ошибка проверки файла (например, файл удален) приводит к пересборке кэша с выводом ошибки чтения
+		inode, size, err := app.statAuditLog(ctx, path)
 		if err != nil {
-			return nil, err
-		}
-		// Парсим вывод stat (пример вывода: /var/log/syslog|8744995|1756116219)
-		line := strings.TrimSpace(string(output))
-		parts := strings.Split(line, "|")
-		if len(parts) != 3 {
-			return nil, fmt.Errorf("%w: %s", ErrInvalidStat, line)
+			return nil, false, nil
 		}
-		// Преобразуем размер и время в int
-		size, err := strconv.ParseInt(parts[1], 10, 64)
-		if err != nil {
-			return nil, err
+		if inode != cache.inode || size < cache.offset {
+			return nil, false, nil
 		}
-		modTimeUnix, err := strconv.ParseInt(parts[2], 10, 64)
-		if err != nil {
-			return nil, err
+		if size == cache.offset {
+			return nil, true, nil
 		}
-		modTime := time.Unix(modTimeUnix, 0)
-		// Создаем кастомный FileInfo
-		return &fileInfo{
-			name:    parts[0],
-			size:    size,
-			modTime: modTime,
-		}, nil
-	} else {
-		// В локальном режиме возвращяем стандартный os.Stat
-		return os.Stat(path)
+		data, err = app.runCommandContext(ctx, 0, "Reading the audit log", "tail", "-c", "+"+strconv.FormatInt(cache.offset+1, 10), path)
+		return data, err == nil, err
 	}
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, false, nil
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil || cache.info == nil {
+		return nil, false, nil
+	}
+	if !os.SameFile(info, cache.info) || info.Size() < cache.offset {
+		return nil, false, nil
+	}
+	data, err = io.ReadAll(io.NewSectionReader(file, cache.offset, info.Size()-cache.offset))
+	return data, err == nil, err
 }
 
-// Получение массива статистики по всем файлам
-func (app *App) statFiles(paths []string) (map[string]os.FileInfo, error) {
-	if len(paths) == 0 {
-		return make(map[string]os.FileInfo), nil
+// Функция для получения inode и размера файла журнала аудита на удаленном хосте
+func (app *App) statAuditLog(ctx context.Context, path string) (string, int64, error) {
+	output, err := app.runCommandContext(ctx, 0, "Checking the audit log", "stat", "-c", "%i %s", path)
+	if err != nil {
+		return "", 0, err
 	}
-	// Удаляем лишние символы из путей
-	replPaths := strings.Join(paths, "\n")
-	replPaths = strings.ReplaceAll(replPaths, "(", "")
-	replPaths = strings.ReplaceAll(replPaths, ")", "")
-	paths = strings.Split(replPaths, "\n")
-	args := make([]string, len(app.sshOptions))
-	copy(args, app.sshOptions)
-	args = append(args, "stat", "-L", "-c", "'%n|%s|%Y'")
-	args = append(args, paths...)
-	cmd := exec.Command("ssh", args...)
-	if app.logging {
-		slog.Info(cmd.String(), "action", "Loading the log files")
+	inode, sizeText, _ := strings.Cut(strings.TrimSpace(string(output)), " ")
+	size, err := strconv.ParseInt(sizeText, 10, 64)
+	if err != nil {
+		return "", 0, fmt.Errorf("unexpected stat output: %q", output)
 	}
-	output, _ := cmd.Output()
-	results := make(map[string]os.FileInfo)
-	lines := strings.Split(strings.TrimSpace(string(output)), "\n")
-	for _, line := range lines {
-		if strings.TrimSpace(line) == "" {
-			continue
-		}
-		parts := strings.Split(line, "|")
-		if len(parts) != 3 {
-			return nil, fmt.Errorf("%w: %s", ErrInvalidStat, line)
+	return inode, size, nil
+}
+
+// Функция для чтения всех файлов журнала аудита в кэш (ротированные файлы читаются только при пересборке кэша)
+func (app *App) readAuditFiles(ctx context.Context, cache *auditCache, path string) error {
+	files, err := app.auditLogFiles(ctx)
+	if err != nil {
+		return err
+	}
+	rotated := files[:len(files)-1]
+	if files[len(files)-1] != path {
+		rotated = files
+	}
+	var data, current, passwd []byte
+	var info os.FileInfo
+	var inode string
+	if app.sshMode {
+		if len(rotated) > 0 {
+			data, err = app.runCommandContext(ctx, 0, "Reading the audit log", "cat", rotated...)
+			if err != nil {
+				return err
+			}
 		}
-		size, err := strconv.ParseInt(parts[1], 10, 64)
-		if err != nil {
-			return nil, err
+		if len(rotated) < len(files) {
+			// Размер текущего файла определяется по прочитанным байтам, поэтому inode запрашивается до чтения
+			inode, _, err = app.statAuditLog(ctx, path)
+			if err != nil {
+				return err
+			}
+			current, err = app.runCommandContext(ctx, 0, "Reading the audit log", "cat", path)
+			if err != nil {
+				return err
+			}
 		}
-		modTimeUnix, err := strconv.ParseInt(parts[2], 10, 64)
-		if err != nil {
-			return nil, err
+		passwd, _ = app.runCommandContext(ctx, 0, "Reading the user names", "cat", "/etc/passwd")
+	} else {
+		for _, file := range rotated {
+			fileData, err := os.ReadFile(file)
+			if err != nil {
+				return err
+			}
+			data = append(data, fileData...)
 		}
-		modTime := time.Unix(modTimeUnix, 0)
-		results[parts[0]] = &fileInfo{
-			name:    parts[0],
-			size:    size,
-			modTime: modTime,
+		if len(rotated) < len(files) {
+			file, err := os.Open(path)
+			if err != nil {
+				return err
+			}
+			info, err = file.Stat()
+			if err == nil {
+				current, err = io.ReadAll(file)
+			}
+			file.Close()
+			if err != nil {
+				return err
+			}
 		}
+		passwd, _ = os.ReadFile("/etc/passwd")
 	}
-	return results, nil
+	cache.path, cache.info, cache.inode = path, info, inode
+	cache.offset, cache.partial = int64(len(current)), ""
+	cache.events, cache.index = nil, make(map[string]int)
+	cache.users = parsePasswd(string(passwd))
+	// Неполная последняя строка ротированного файла не объединяется со строками текущего
+	if len(data) > 0 && !bytes.HasSuffix(data, []byte("\n")) {
+		data = append(data, '\n')
+	}
+	cache.appendData(append(data, current...))
+	return nil
 }
 
-func (app *App) loadFiles(logPath string) {
-	app.logfiles = nil // сбрасываем (очищаем) массив перед загрузкой новых журналов
-	var output []byte
-	switch logPath {
-	case "descriptor":
-		var cmd *exec.Cmd
-		if app.sshMode {
-			cmd = exec.Command(
-				"ssh", append(app.sshOptions,
-					"lsof", "-Fn",
-				)...)
-		} else {
-			cmd = exec.Command("lsof", "-Fn")
-		}
-		// Подавить вывод ошибок при отсутствиее прав доступа (opendir: Permission denied)
-		cmd.Stderr = nil
-		if app.logging {
-			slog.Info(cmd.String(), "action", "Loading the log files for process descriptor")
-		}
-		output, _ = cmd.Output()
-		// Разбиваем вывод на строки
-		files := strings.Split(strings.TrimSpace(string(output)), "\n")
-		// Если список файлов пустой, возвращаем ошибку Permission denied
-		if !app.testMode {
-			if len(files) == 0 || (len(files) == 1 && files[0] == "") {
-				vError, _ := app.gui.View("varLogs")
-				vError.Clear()
-				// Меняем цвет окна на красный
-				app.fileSystemFrameColor = app.errorColor
-				vError.FrameColor = app.fileSystemFrameColor
-				// Отключаем курсор и выводим сообщение об ошибке
-				vError.Highlight = false
-				fmt.Fprintln(vError, "\033[31mPermission denied (files not found)\033[0m")
-				return
-			} else {
-				vError, _ := app.gui.View("varLogs")
-				app.fileSystemFrameColor = app.frameColor
-				if vError.FrameColor != app.frameColor {
-					vError.FrameColor = app.selectedFrameColor
-				}
-				vError.Highlight = true
-			}
+// Функция для разбора дописанных строк журнала аудита (неполная последняя строка откладывается до следующего чтения)
+func (c *auditCache) appendData(data []byte) {
+	if len(data) == 0 {
+		return
+	}
+	lines := strings.Split(c.partial+string(data), "\n")
+	c.partial = lines[len(lines)-1]
+	c.events = appendAuditLog(c.events, c.index, lines[:len(lines)-1], c.users)
+}
+
+// Функция для загрузки списка всех журналов событий Windows через PowerShell
+func (app *App) loadWinEvents() {
+	app.debugStartTime = time.Now()
+	app.journals = nil
+	// Получаем список, игнорируем ошибки, фильтруем пустые журналы, забираем нужные параметры, сортируем и выводим в формате JSON
+	eventsJson, _ := app.localCmdExecutor().Output(context.Background(), Command{
+		Name: "powershell",
+		Args: []string{"-Command",
+			"Get-WinEvent -ListLog * -ErrorAction Ignore | " +
+				"Where-Object RecordCount -ne 0 | " +
+				"Where-Object RecordCount -ne $null | " +
+				"Select-Object LogName,RecordCount | " +
+				"Sort-Object -Descending RecordCount | " +
+				"ConvertTo-Json",
+		},
+		Action: "Loading the windows event logs",
+	})
+	var events []map[string]any
+	_ = json.Unmarshal(eventsJson, &events)
+	for _, event := range events {
+		// Извлечение названия журнала и количество записей
+		LogName, _ := event["LogName"].(string)
+		RecordCount, _ := event["RecordCount"].(float64)
+		RecordCountInt := int(RecordCount)
+		RecordCountString := strconv.Itoa(RecordCountInt)
+		// Удаляем приставку
+		LogView := strings.ReplaceAll(LogName, "Microsoft-Windows-", "")
+		// Разбивает строку на 2 части для покраски
+		LogViewSplit := strings.SplitN(LogView, "/", 2)
+		if len(LogViewSplit) == 2 {
+			LogView = "\033[33m" + LogViewSplit[0] + "\033[0m" + ": " + "\033[36m" + LogViewSplit[1] + "\033[0m"
 		} else {
-			if len(files) == 0 || (len(files) == 1 && files[0] == "") {
-				log.Print("Error: permission denied (files not found from descriptor)")
-			}
-		}
-		// Очищаем массив перед добавлением отфильтрованных файлов
-		output = []byte{}
-		// Фильтруем строки, которые заканчиваются на ".log" и удаляем префикс (имя файла)
-		for _, file := range files {
-			if strings.HasSuffix(file, ".log") {
-				file = strings.TrimPrefix(file, "n")
-				output = append(output, []byte(file+"\n")...)
-			}
+			LogView = "\033[36m" + LogView + "\033[0m"
 		}
-	case "varlog":
-		logPath = "/var/log/"
-		var cmd *exec.Cmd
-		// Загрузка системных журналов для macOS
-		if app.getOS == "darwin" {
-			args := []string{
-				logPath, "/Library/Logs",
-				"-type", "f",
-				"-name", "*.asl", "-o",
-				"-name", "*.log", "-o",
-				"-name", "*log*", "-o",
-				"-name", "*.[0-9]*", "-o",
-				"-name", "*.[0-9].*", "-o",
-				"-name", "*.pcap", "-o",
-				"-name", "*.pcap.gz", "-o",
-				"-name", "*.pcapng", "-o",
-				"-name", "*.pcapng.gz",
-			}
-			if app.sshMode {
-				sshArgs := make([]string, 0, len(app.sshOptions)+1+len(args))
-				sshArgs = append(sshArgs, app.sshOptions...)
-				sshArgs = append(sshArgs, "find")
-				sshArgs = append(sshArgs, args...)
-				cmd = exec.Command("ssh", sshArgs...)
-			} else {
-				cmd = exec.Command("find", args...)
-			}
+		LogView = LogView + " (" + RecordCountString + ")"
+		app.journals = append(app.journals, Journal{
+			name:    LogView,
+			boot_id: LogName,
+		})
+	}
+	journals := app.journals
+	app.uiUpdate(func(app *App) {
+		app.journals = journals
+		app.journalsNotFilter = app.journals
+		app.applyFilterList()
+	})
+}
+
+// Функция для обновления окна со списком служб
+func (app *App) updateServicesList() {
+	// Выбираем окно для заполнения в зависимости от используемого журнала
+	v, err := app.gui.View("services")
+	if err != nil {
+		return
+	}
+	// Очищаем окно
+	v.Clear()
+	// Вычисляем конечную позицию видимой области (стартовая позиция + максимальное количество видимых строк)
+	visibleEnd := min(app.startServices+app.maxVisibleServices, len(app.journals))
+	// Отображаем только элементы в пределах видимой области
+	for i := app.startServices; i < visibleEnd; i++ {
+		if slices.Contains(app.markedJournals, removeStatusPrefix(removeANSI(app.journals[i].name))) {
+			fmt.Fprintln(v, "\033[33m"+journalMarkPrefix+"\033[0m"+app.journals[i].name)
 		} else {
-			// Загрузка системных журналов для Linux: все файлы, которые содержат log в расширение или названии (архивы включительно), а также расширение с цифрой (архивные) и pcap/pcapng
-			args := []string{
-				logPath,
-				"-type", "f",
-				"-name", "*.log", "-o",
-				"-name", "*log*", "-o",
-				"-name", "*.[0-9]*", "-o",
-				"-name", "*.[0-9].*", "-o",
-				"-name", "*.pcap", "-o",
-				"-name", "*.pcap", "-o",
-				"-name", "*.pcap.gz", "-o",
-				"-name", "*.pcapng", "-o",
-				"-name", "*.pcapng.gz",
-			}
-			if app.sshMode {
-				sshArgs := app.sshOptions
-				sshArgs = append(sshArgs, "find")
-				sshArgs = append(sshArgs, args...)
-				cmd = exec.Command("ssh", sshArgs...)
-			} else {
-				cmd = exec.Command("find", args...)
-			}
+			fmt.Fprintln(v, app.journals[i].name)
 		}
-		if app.logging {
-			slog.Info(cmd.String(), "action", "Loading the log files from var logs")
+	}
+}
+
+// Отметка журнала в списке для объединенного вывода
+const journalMarkPrefix = "* "
+
+// Функция для получения названия журнала из строки списка служб (без отметки и статуса)
+func serviceLineName(line string) string {
+	return removeStatusPrefix(strings.TrimSpace(strings.TrimPrefix(line, journalMarkPrefix)))
+}
+
+// Функция для отметки журнала под курсором для объединенного вывода (отметка _all сбрасывает все отметки)
+func (app *App) markService(g *gocui.Gui, v *gocui.View) error {
+	if v == nil || len(app.journals) == 0 || app.getOS == "windows" {
+		return nil
+	}
+	// Объединение доступно только для юнитов и системных журналов, сравнение - для двух загрузок ядра
+	if app.selectUnits != "systemUnits" && app.selectUnits != "userUnits" && app.selectUnits != "systemJournals" && app.selectUnits != "kernelBoot" {
+		return nil
+	}
+	_, cy := v.Cursor()
+	line, err := v.Line(cy)
+	if err != nil {
+		return err
+	}
+	name := serviceLineName(line)
+	switch {
+	case name == "_all":
+		app.markedJournals = nil
+	case slices.Contains(app.markedJournals, name):
+		app.markedJournals = slices.DeleteFunc(slices.Clone(app.markedJournals), func(marked string) bool {
+			return marked == name
+		})
+	// Для сравнения загрузок ядра отмечаются только две последние выбранные загрузки
+	case app.selectUnits == "kernelBoot" && len(app.markedJournals) >= 2:
+		app.markedJournals = append(slices.Clone(app.markedJournals[len(app.markedJournals)-1:]), name)
+	default:
+		app.markedJournals = append(slices.Clone(app.markedJournals), name)
+	}
+	app.updateServicesList()
+	return nil
+}
+
+// Функция для перемещения по списку журналов вниз
+func (app *App) nextService(v *gocui.View, step int) error {
+	// Обновляем текущее количество видимых строк в терминале (-1 заголовок)
+	_, viewHeight := v.Size()
+	app.maxVisibleServices = viewHeight
+	// Если список журналов пустой, ничего не делаем
+	if len(app.journals) == 0 {
+		return nil
+	}
+	// Переходим к следующему, если текущий выбранный журнал не последний
+	if app.selectedJournal < len(app.journals)-1 {
+		// Увеличиваем индекс выбранного журнала
+		app.selectedJournal += step
+		// Проверяем, чтобы не выйти за пределы списка
+		if app.selectedJournal >= len(app.journals) {
+			app.selectedJournal = len(app.journals) - 1
 		}
-		output, _ = cmd.Output()
-		// Преобразуем вывод команды в строку и делим на массив строк
-		files := strings.Split(strings.TrimSpace(string(output)), "\n")
-		// Если список файлов пустой, возвращаем ошибку Permission denied
-		if !app.testMode {
-			if len(files) == 0 || (len(files) == 1 && files[0] == "") {
-				vError, _ := app.gui.View("varLogs")
-				vError.Clear()
-				// Меняем цвет окна на красный
-				app.fileSystemFrameColor = app.errorColor
-				vError.FrameColor = app.fileSystemFrameColor
-				// Отключаем курсор и выводим сообщение об ошибке
-				vError.Highlight = false
-				fmt.Fprintln(vError, "\033[31mPermission denied (files not found)\033[0m")
-				return
-			} else {
-				vError, _ := app.gui.View("varLogs")
-				app.fileSystemFrameColor = app.frameColor
-				if vError.FrameColor != app.frameColor {
-					vError.FrameColor = app.selectedFrameColor
-				}
-				vError.Highlight = true
-			}
-		} else {
-			if len(files) == 0 || (len(files) == 1 && files[0] == "") {
-				log.Print("Error: files not found in /var/log")
+		// Проверяем, вышли ли за пределы видимой области (увеличиваем стартовую позицию видимости, только если дошли до 0 + maxVisibleServices)
+		if app.selectedJournal >= app.startServices+app.maxVisibleServices {
+			// Сдвигаем видимую область вниз
+			app.startServices += step
+			// Проверяем, чтобы не выйти за пределы списка
+			if app.startServices > len(app.journals)-app.maxVisibleServices {
+				app.startServices = len(app.journals) - app.maxVisibleServices
 			}
+			// Обновляем отображение списка служб
+			app.updateServicesList()
 		}
-		// Добавляем пути по умолчанию для /var/log
-		logPaths := []string{
-			// Ядро
-			"/var/log/dmesg\n",
-			// Информация о входах и выходах пользователей, перезагрузках и остановках системы
-			"/var/log/wtmp\n",
-			// Информация о неудачных попытках входа в систему (например, неправильные пароли)
-			"/var/log/btmp\n",
-			// Информация о текущих пользователях, их сеансах и входах в систему
-			"/var/run/utmp\n",
-			"/run/utmp\n",
-			// macOS/BSD/RHEL
-			"/var/log/secure\n",
-			"/var/log/messages\n",
-			"/var/log/daemon\n",
-			"/var/log/lpd-errs\n",
-			"/var/log/security.out\n",
-			"/var/log/daily.out\n",
-			// Службы
-			"/var/log/cron\n",
-			"/var/log/ftpd\n",
-			"/var/log/ntpd\n",
-			"/var/log/named\n",
-			"/var/log/dhcpd\n",
+		// Если сдвинули видимую область, корректируем индекс для смещения курсора в интерфейсе
+		if app.selectedJournal < app.startServices+app.maxVisibleServices {
+			// Выбираем журнал по скорректированному индексу
+			return app.selectServiceByIndex(app.selectedJournal - app.startServices)
 		}
-		for _, path := range logPaths {
-			output = append([]byte(path), output...)
+	}
+	return nil
+}
+
+// Функция для перемещения по списку журналов вверх
+func (app *App) prevService(v *gocui.View, step int) error {
+	_, viewHeight := v.Size()
+	app.maxVisibleServices = viewHeight
+	if len(app.journals) == 0 {
+		return nil
+	}
+	// Переходим к предыдущему, если текущий выбранный журнал не первый
+	if app.selectedJournal > 0 {
+		app.selectedJournal -= step
+		// Если ушли в минус (за начало журнала), приводим к нулю
+		if app.selectedJournal < 0 {
+			app.selectedJournal = 0
 		}
-	case "customPath":
-		logPath = app.customPath
-		var cmd *exec.Cmd
-		if app.sshMode {
-			cmd = exec.Command(
-				"ssh", append(app.sshOptions,
-					"find", logPath,
-					"-type", "f",
-					"-name", "*.log", "-o",
-					"-name", "*.log.*", "-o",
-					"-name", "*.asl", "-o",
-					"-name", "*.pcap", "-o",
-					"-name", "*.pcap.gz", "-o",
-					"-name", "*.pcapng", "-o",
-					"-name", "*.pcapng.gz",
-				)...,
-			)
-		} else {
-			cmd = exec.Command(
-				"find", logPath,
-				"-type", "f",
-				"-name", "*.log", "-o",
-				"-name", "*.log.*", "-o",
-				"-name", "*.asl", "-o",
-				"-name", "*.pcap", "-o",
-				"-name", "*.pcap.gz", "-o",
-				"-name", "*.pcapng", "-o",
-				"-name", "*.pcapng.gz",
-			)
+		// Проверяем, вышли ли за пределы видимой области
+		if app.selectedJournal < app.startServices {
+			app.startServices -= step
+			if app.startServices < 0 {
+				app.startServices = 0
+			}
+			app.updateServicesList()
 		}
-		if app.logging {
-			slog.Info(cmd.String(), "action", "Loading the log files from custom path")
+		if app.selectedJournal >= app.startServices {
+			return app.selectServiceByIndex(app.selectedJournal - app.startServices)
 		}
-		output, _ = cmd.Output()
-		files := strings.Split(strings.TrimSpace(string(output)), "\n")
-		if !app.testMode {
-			if len(files) == 0 || (len(files) == 1 && files[0] == "") {
-				vError, _ := app.gui.View("varLogs")
-				vError.Clear()
-				// Меняем цвет окна на красный
-				app.fileSystemFrameColor = app.errorColor
-				vError.FrameColor = app.fileSystemFrameColor
-				// Отключаем курсор и выводим сообщение об ошибке
-				vError.Highlight = false
-				fmt.Fprintln(vError, "\033[31mFiles not found\033[0m")
-				return
-			} else {
-				vError, _ := app.gui.View("varLogs")
-				app.fileSystemFrameColor = app.frameColor
-				if vError.FrameColor != app.frameColor {
-					vError.FrameColor = app.selectedFrameColor
-				}
-				vError.Highlight = true
-			}
-		} else {
-			if len(files) == 0 || (len(files) == 1 && files[0] == "") {
-				log.Print("Error: files not found in ", logPath)
+	}
+	return nil
+}
+
+// Функция для визуального выбора журнала по индексу (смещение курсора выделения)
+func (app *App) selectServiceByIndex(index int) error {
+	// Получаем доступ к представлению списка служб
+	v, err := app.gui.View("services")
+	if err != nil {
+		return err
+	}
+	// Обновляем счетчик в заголовке
+	re := regexp.MustCompile(`\s\(.+\) >`)
+	updateTitle := " (0) >"
+	if len(app.journals) != 0 {
+		updateTitle = " (" + strconv.Itoa(app.selectedJournal+1) + "/" + strconv.Itoa(len(app.journals)) + ") >"
+	}
+	v.Title = re.ReplaceAllString(v.Title, updateTitle)
+	// Устанавливаем курсор на нужный индекс (строку)
+	// Первый столбец (0), индекс строки
+	if err := v.SetCursor(0, index); err != nil {
+		return nil
+	}
+	// Обновляем информацию о юните под курсором
+	app.updateUnitDetail()
+	return nil
+}
+
+// Функция для выбора журнала в списке сервисов по нажатию Enter
+func (app *App) selectService(g *gocui.Gui, v *gocui.View) error {
+	// Проверка, что есть доступ к представлению и список журналов не пустой
+	if v == nil || len(app.journals) == 0 {
+		return nil
+	}
+	// Получаем текущую позицию курсора
+	_, cy := v.Cursor()
+	// Читаем строку, на которой находится курсор
+	line, err := v.Line(cy)
+	if err != nil {
+		return err
+	}
+	// Включаем загрузку журнала (только при ручном выборе для Windows)
+	app.updateFile = true
+	// Загружаем журналы выбранной службы, обрезая пробелы и отметку в названии
+	app.selectLogSource("services", strings.TrimSpace(strings.TrimPrefix(line, journalMarkPrefix)))
+	app.updateUnitDetail()
+	return nil
+}
+
+// Функция для загрузки записей журнала выбранной службы через journalctl
+// Второй параметр для обнолвения позиции делимитра нового вывода лога а также сброса автоскролл
+func (app *App) loadJournalLogs(ctx context.Context, serviceName string, newUpdate bool) {
+	// Сбрасываем последнюю используемую систему контейнеризации (ошибка при покраске после compose)
+	app.lastContainerizationSystem = ""
+	if serviceName == "" {
+		return
+	}
+	app.debugStartTime = time.Now()
+	var output []byte
+	var err error
+	// Сохраняем список и название журнала для автообновления при смене окна
+	if newUpdate {
+		app.journaldLogs.units = app.selectUnits
+		app.journaldLogs.name = serviceName
+		app.journaldLogs.marked = slices.Clone(app.markedJournals)
+	}
+	app.journaldLogs.grep = ""
+	if app.journaldLogs.grepSupported(app) {
+		app.journaldLogs.grep = strings.Join(app.journalGrepArgs(), " ")
+	}
+	selectUnits := app.journaldLogs.units
+	// Обновляем статус с названием источника журнала (название юнита)
+	app.setLogSubtitle(app.journaldLogs.Describe())
+	switch {
+	// Читаем журналы Windows
+	case app.getOS == "windows":
+		if !app.updateFile {
+			return
+		}
+		// Отключаем чтение в горутине
+		app.updateFile = false
+		// Извлекаем полное имя события
+		var eventName string
+		for _, journal := range app.journals {
+			journalBootName := removeANSI(journal.name)
+			if journalBootName == serviceName {
+				eventName = journal.boot_id
+				break
 			}
 		}
-	default:
-		// Определяем домашний каталог в Linux
-		logPath = "/home/"
-		// Домашний каталог в macOS
-		if app.getOS == "darwin" {
-			logPath = "/Users/"
+		output = app.loadWinEventLog(eventName)
+		if len(output) == 0 && !app.testMode {
+			app.showLogError()
+			return
 		}
-		// Ищем файлы с помощью системной утилиты find
-		var cmd *exec.Cmd
-		args := []string{
-			logPath,
-			"-type", "f",
-			"-name", "*.log", "-o",
-			"-name", "*.asl", "-o",
-			"-name", "*.pcap", "-o",
-			"-name", "*.pcap.gz", "-o",
-			"-name", "*.pcapng", "-o",
-			"-name", "*.pcapng.gz",
+		if len(output) == 0 && app.testMode {
+			app.currentLogRecords = []LogRecord{}
+			return
 		}
-		if app.sshMode {
-			sshArgs := app.sshOptions
-			sshArgs = append(sshArgs, "find")
-			sshArgs = append(sshArgs, args...)
-			cmd = exec.Command("ssh", sshArgs...)
+	// Читаем события журнала аудита, выбранные по ключу правила, типу записи, пользователю или исполняемому файлу
+	case selectUnits == "auditd":
+		var groupId string
+		for _, journal := range app.journals {
+			if removeANSI(journal.name) == serviceName {
+				groupId = journal.boot_id
+				break
+			}
+		}
+		if newUpdate {
+			app.journaldLogs.bootId = groupId
 		} else {
-			cmd = exec.Command("find", args...)
+			groupId = app.journaldLogs.bootId
 		}
-		if app.logging {
-			slog.Info(cmd.String(), "action", "Loading the log files from users home")
+		events, err := app.loadAuditEvents(ctx)
+		// Пропускаем результат загрузки, отмененной выбором другого журнала
+		if ctx.Err() != nil {
+			return
 		}
-		output, _ = cmd.Output()
-		files := strings.Split(strings.TrimSpace(string(output)), "\n")
-		if !app.testMode {
-			if len(files) == 0 || (len(files) == 1 && files[0] == "") {
-				vError, _ := app.gui.View("varLogs")
-				vError.Clear()
-				vError.Highlight = false
-				fmt.Fprintln(vError, "\033[32mFiles not found\033[0m")
-				return
-			} else {
-				vError, _ := app.gui.View("varLogs")
-				app.fileSystemFrameColor = app.frameColor
-				if vError.FrameColor != app.frameColor {
-					vError.FrameColor = app.selectedFrameColor
-				}
-				vError.Highlight = true
-			}
-		} else {
-			if len(files) == 0 || (len(files) == 1 && files[0] == "") {
-				log.Print("Error: files not found in home directories")
-			}
+		if err != nil && !app.testMode {
+			app.showLogError("\033[31mError getting auditd logs:", err, "\033[0m")
+			return
 		}
-		// Получаем содержимое файлов из домашнего каталога пользователя root
-		var cmdRootDir *exec.Cmd
-		args = []string{
-			"/root/",
-			"-type", "f",
-			"-name", "*.log", "-o",
-			"-name", "*.pcap", "-o",
-			"-name", "*.pcap.gz", "-o",
-			"-name", "*.pcapng", "-o",
-			"-name", "*.pcapng.gz",
+		if err != nil && app.testMode {
+			log.Print("Error: getting auditd logs. ", err)
 		}
-		if app.sshMode {
-			sshArgs := app.sshOptions
-			sshArgs = append(sshArgs, "find")
-			sshArgs = append(sshArgs, args...)
-			cmdRootDir = exec.Command("ssh", sshArgs...)
-		} else {
-			cmdRootDir = exec.Command("find", args...)
+		events = filterAuditEvents(events, groupId)
+		// Пропускаем обновление вывода, если новых событий нет
+		var lastId string
+		if len(events) > 0 {
+			lastId = events[len(events)-1].id
 		}
-		if app.logging {
-			slog.Info(cmd.String(), "action", "Loading the log files from users home")
+		if !newUpdate && lastId == app.journaldLogs.cursor {
+			return
 		}
-		outputRootDir, err := cmdRootDir.Output()
-		// Добавляем содержимое директории /root/ в общий массив, если есть доступ
-		if err == nil {
-			output = append(output, outputRootDir...)
+		app.journaldLogs.cursor = lastId
+		if count, convErr := strconv.Atoi(app.logViewCount); convErr == nil && len(events) > count {
+			events = events[len(events)-count:]
 		}
-		if app.fileSystemFrameColor == app.errorColor && !app.testMode {
-			vError, _ := app.gui.View("varLogs")
-			app.fileSystemFrameColor = app.frameColor
-			if vError.FrameColor != app.frameColor {
-				vError.FrameColor = app.selectedFrameColor
+		var records []LogRecord
+		for _, event := range events {
+			records = append(records, auditEventRecords(event)...)
+		}
+		app.currentLogRecords = append(records, LogRecord{})
+	// Читаем информацию о выбранном дампе памяти
+	case selectUnits == "coredumps":
+		var coredumpId string
+		for _, journal := range app.journals {
+			if removeANSI(journal.name) == serviceName {
+				coredumpId = journal.boot_id
+				break
 			}
-			vError.Highlight = true
 		}
-	}
-	// Формируем массив путей
-	logFullPaths := strings.Split(strings.TrimSpace(string(output)), "\n")
-	// Получаем статистику по всем файлам одним вызовом в режиме ssh
-	var statFiles map[string]os.FileInfo
-	if app.sshMode {
-		statFiles, _ = app.statFiles(logFullPaths)
-	}
-	// Карта уникальных путей
-	serviceMap := make(map[string]bool)
-	// Основной цикл
-	for _, logFullPath := range logFullPaths {
-		// Удаляем префикс пути и расширение файла в конце
-		logName := logFullPath
-		if logPath != "descriptor" {
-			logName = strings.TrimPrefix(logFullPath, logPath)
+		if newUpdate {
+			app.journaldLogs.bootId = coredumpId
+		} else {
+			coredumpId = app.journaldLogs.bootId
 		}
-		logName = strings.TrimSuffix(logName, ".log")
-		logName = strings.TrimSuffix(logName, ".asl")
-		logName = strings.TrimSuffix(logName, ".gz")
-		logName = strings.TrimSuffix(logName, ".xz")
-		logName = strings.TrimSuffix(logName, ".bz2")
-		logName = strings.ReplaceAll(logName, "/", " ")
-		logName = strings.ReplaceAll(logName, ".log.", ".")
-		logName = strings.TrimPrefix(logName, " ")
-		if logPath == "/home/" || logPath == "/Users/" {
-			// Разбиваем строку на слова
-			words := strings.Fields(logName)
-			// Берем первое и последнее слово
-			firstWord := words[0]
-			lastWord := words[len(words)-1]
-			logName = "\x1b[0;33m" + firstWord + "\033[0m" + ": " + lastWord
+		output, err = app.loadCoredumpInfo(ctx, coredumpId)
+		if ctx.Err() != nil {
+			return
 		}
-		// Получаем информацию о файле
-		var fileInfo os.FileInfo
-		var exists bool
-		var err error
-		if app.sshMode {
-			// Извлекаем статистику из массива
-			fileInfo, exists = statFiles[logFullPath]
-			// Пропускаем файл, если он не найден в результатах
-			if !exists {
-				continue
+		if err != nil && !app.testMode {
+			app.showLogError("\033[31mError getting core dump information:", err, "\033[0m")
+			return
+		}
+		if err != nil && app.testMode {
+			log.Print("Error: getting core dump information. ", err)
+		}
+	// Сравниваем сообщения ядра двух отмеченных загрузок
+	case selectUnits == "kernelBoot" && len(app.journaldLogs.marked) == 2:
+		output, err = app.loadKernelBootDiff(ctx, app.journaldLogs.marked)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil && !app.testMode {
+			app.showLogError("\033[31mError comparing kernel boots:", err, "\033[0m")
+			return
+		}
+		if err != nil && app.testMode {
+			log.Print("Error: comparing kernel boots. ", err)
+		}
+	// Читаем лог ядра загрузки системы
+	case selectUnits == "kernelBoot":
+		// Извлекаем id журнала из названия
+		var boot_id string
+		for _, journal := range app.journals {
+			journalBootName := removeANSI(journal.name)
+			if journalBootName == serviceName {
+				boot_id = journal.boot_id
+				break
 			}
+		}
+		// Сохраняем название для обновления вывода журнала при фильтрации списков
+		if newUpdate {
+			app.journaldLogs.bootId = boot_id
 		} else {
-			// Запрашиваем статистику для каждого файла в локальном режиме
-			fileInfo, err = os.Stat(logFullPath)
-			if err != nil {
-				// Пропускаем файл, если к нему нет доступа (актуально для статических файлов из переменной logPath)
-				continue
-			}
+			boot_id = app.journaldLogs.bootId
 		}
-		// Проверяем, что файл не пустой
-		if fileInfo.Size() == 0 {
-			// Пропускаем пустой файл
-			continue
+		args := append(app.journalSourceArgs(), "-k", "-b", boot_id, "--no-pager", "-n", app.logViewCount)
+		args = append(args, app.journalGrepArgs()...)
+		if app.journalJson {
+			args = append(args, "--output=json")
 		}
-		// Получаем дату изменения
-		modTime := fileInfo.ModTime()
-		// Форматирование даты в формат DD.MM.YYYY HH:MM
-		formattedDate := modTime.Format("02.01.2006 15:04")
-		// Проверяем, что полного пути до файла еще нет в списке
-		if logName != "" && !serviceMap[logFullPath] {
-			// Добавляем путь в массив для проверки уникальных путей
-			serviceMap[logFullPath] = true
-			// Получаем имя процесса для файла дескриптора
-			if logPath == "descriptor" {
-				var cmd *exec.Cmd
-				if app.sshMode {
-					cmd = exec.Command(
-						"ssh", append(app.sshOptions,
-							"lsof", "-Fc", logFullPath,
-						)...)
-				} else {
-					cmd = exec.Command(
-						"lsof", "-Fc", logFullPath,
-					)
-				}
-				cmd.Stderr = nil
-				if app.logging {
-					slog.Info(cmd.String(), "action", "Loading the log files for process descriptor")
-				}
-				outputLsof, _ := cmd.Output()
-				processLines := strings.Split(strings.TrimSpace(string(outputLsof)), "\n")
-				// Ищем строку, которая содержит имя процесса (только первый процесс)
-				for _, line := range processLines {
-					if strings.HasPrefix(line, "c") {
-						// Удаляем префикс
-						processName := line[1:]
-						logName = "\x1b[0;33m" + processName + "\033[0m" + ": " + logName
-						break
-					}
-				}
-			}
-			// Выделение цветом подов и контейнеров k3s из файловой системы
-			if strings.HasPrefix(logName, "pods") {
-				logName = strings.Replace(logName, "pods", "\033[33mpod\033[0m", 1)
+		updated, err := app.readJournal(ctx, "Reading logs from kernel boot", args, newUpdate)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil && !app.testMode {
+			app.showLogError("\033[31mError getting kernal logs:", err, "\033[0m")
+			return
+		}
+		if err != nil && app.testMode {
+			log.Print("Error: getting kernal logs. ", err)
+		}
+		// Пропускаем обновление вывода, если новых записей нет
+		if !updated {
+			return
+		}
+	// Загрузка журналов для юнитов systemd (--unit=UNIT) и системных журналов с фильтрацией (--field=FIELD)
+	default:
+		// Удаляем статусы сервисов из навзания
+		serviceNameNew := strings.Split(serviceName, "] ")
+		if len(serviceNameNew) >= 2 {
+			serviceName = serviceNameNew[1]
+		}
+		// Отмеченные журналы загружаются вместо выбранного одной командой
+		serviceNames := []string{serviceName}
+		if len(app.journaldLogs.marked) > 0 {
+			serviceNames = app.journaldLogs.marked
+		}
+		// Уникальная покраска префиксов объединенных журналов
+		if newUpdate && len(serviceNames) > 1 {
+			if app.uniquePrefixColorMap == nil {
+				app.uniquePrefixColorMap = make(map[string]string)
 			}
-			if strings.HasPrefix(logName, "containers") {
-				logName = strings.Replace(logName, "containers", "\033[32mcontainer\033[0m", 1)
+			clear(app.uniquePrefixColorMap)
+			for i, name := range serviceNames {
+				app.uniquePrefixColorMap[name] = uniquePrefixColorArr[i%len(uniquePrefixColorArr)]
 			}
-			// Добавляем в список
-			app.logfiles = append(app.logfiles, Logfile{
-				name: "[" + "\033[34m" + formattedDate + "\033[0m" + "] " + logName,
-				path: logFullPath,
-			})
 		}
-	}
-	// Сортируем по дате
-	sort.Slice(app.logfiles, func(i, j int) bool {
-		// Извлечение дат из имени
-		layout := "02.01.2006 15:04"
-		dateI, _ := time.Parse(layout, extractDate(app.logfiles[i].name))
-		dateJ, _ := time.Parse(layout, extractDate(app.logfiles[j].name))
-		// return dateI.Before(dateJ)
-		// Сортировка в обратном порядке
-		return dateI.After(dateJ)
-	})
-	if !app.testMode {
-		app.logfilesNotFilter = app.logfiles
-		app.applyFilterList()
-		v, err := app.gui.View("varLogs")
-		if err == nil {
-			curTime := time.Now().Format("02.01.2006 15:04:05")
-			v.Subtitle = "[ " + curTime + " ]"
+		// #34 Используем массив для формирования аргументов команды
+		args := app.journalFilterArgs(selectUnits, serviceNames...)
+		// Добавляем базовые аргументы
+		args = append(args, "--no-pager")
+		args = append(args, "--lines="+app.logViewCount)
+		// Добавляем аргументы для фильтрации по времени
+		if app.sinceDateFilterMode {
+			args = append(args, "--since", app.sinceFilterText)
 		}
-	}
-}
-
-func (app *App) loadWinFiles(logPath string) {
-	app.logfiles = nil
-	// Определяем путь по параметру
-	switch logPath {
-	case "ProgramFiles":
-		logPath = app.systemDisk + ":\\Program Files"
-	case "ProgramFiles86":
-		logPath = app.systemDisk + ":\\Program Files (x86)"
-	case "ProgramData":
-		logPath = app.systemDisk + ":\\ProgramData"
-	case "AppDataLocal":
-		logPath = app.systemDisk + ":\\Users\\" + app.userName + "\\AppData\\Local"
-	case "AppDataRoaming":
-		logPath = app.systemDisk + ":\\Users\\" + app.userName + "\\AppData\\Roaming"
-	case "WinCustomPath":
-		logPath = app.customPath
-	}
-	// Массив для хранений списка файлов
-	var files []string
-	// Получаем список корневых директорий
-	rootDirs, _ := os.ReadDir(logPath)
-	// Проверяем файлы внутри customPath
-	if logPath == app.customPath {
-		for _, rootFile := range rootDirs {
-			if !rootFile.IsDir() {
-				if strings.HasSuffix(strings.ToLower(rootFile.Name()), ".log") {
-					files = append(files, rootFile.Name())
-				}
-			}
+		if app.untilDateFilterMode {
+			args = append(args, "--until", app.untilFilterText)
 		}
-	}
-	// Доступ к срезу files из нескольких горутин
-	var mu sync.Mutex
-	// Группа ожидания для отслеживания завершения всех горутин
-	var wg sync.WaitGroup
-	// Ищем файлы с помощью WalkDir в Windows
-	for _, rootDir := range rootDirs {
-		// Проверяем, является ли текущий элемент директорией
-		if rootDir.IsDir() {
-			// Увеличиваем счетчик ожидаемых горутин
-			wg.Add(1)
-			go func(dir string) {
-				// Уменьшаем счетчик горутин после завершения выполнения текущей функции
-				defer wg.Done()
-				// Рекурсивно обходим все файлы и подкаталоги в текущей директории
-				err := filepath.WalkDir(filepath.Join(logPath, dir), func(path string, d os.DirEntry, err error) error {
-					if err != nil {
-						// Игнорируем ошибки, чтобы не прерывать поиск
-						return nil
-					}
-					// Проверяем, что текущий элемент не является директорией и имеет расширение .log
-					if !d.IsDir() && strings.HasSuffix(strings.ToLower(d.Name()), ".log") {
-						// Получаем относительный путь (без корневого пути logPath)
-						relPath, _ := filepath.Rel(logPath, path)
-						// Используем мьютекс для добавления файла в срез
-						mu.Lock()
-						files = append(files, relPath)
-						mu.Unlock()
-					}
-					return nil
-				})
-				if err != nil {
-					return
-				}
-			}(
-				// Передаем имя текущей директории в горутину
-				rootDir.Name(),
-			)
+		// Читаем записи в формате JSON со всеми полями (объединенный вывод читается в JSON для определения журнала записи)
+		if app.journalJson || len(serviceNames) > 1 {
+			args = append(args, "--output=json")
 		}
-	}
-	// Ждем завершения всех запущенных горутин
-	wg.Wait()
-	// Объединяем все пути в одну строку, разделенную символом новой строки
-	output := strings.Join(files, "\n")
-	if !app.testMode {
-		// Если список файлов пустой, возвращаем ошибку
-		if len(files) == 0 || (len(files) == 1 && files[0] == "") {
-			vError, _ := app.gui.View("varLogs")
-			vError.Clear()
-			app.fileSystemFrameColor = app.errorColor
-			vError.FrameColor = app.fileSystemFrameColor
-			vError.Highlight = false
-			fmt.Fprintln(vError, "\033[31mPermission denied (files not found)\033[0m")
-			return
-		} else {
-			vError, _ := app.gui.View("varLogs")
-			app.fileSystemFrameColor = app.frameColor
-			if vError.FrameColor != app.frameColor {
-				vError.FrameColor = app.selectedFrameColor
-			}
-			vError.Highlight = true
+		var logSource string
+		switch selectUnits {
+		case "systemUnits":
+			logSource = "Reading logs from system units"
+		case "userUnits":
+			logSource = "Reading logs from user units"
+		default:
+			logSource = "Reading logs from system journals"
 		}
-	} else {
-		if len(files) == 0 || (len(files) == 1 && files[0] == "") {
-			log.Print("Error: files not found in ", logPath)
+		updated, err := app.readJournal(ctx, logSource, args, newUpdate)
+		if ctx.Err() != nil {
+			return
 		}
-	}
-	serviceMap := make(map[string]bool)
-	scanner := bufio.NewScanner(strings.NewReader(string(output)))
-	for scanner.Scan() {
-		// Формируем полный путь к файлу
-		logFullPath := logPath + "\\" + scanner.Text()
-		// Формируем имя файла для списка
-		logName := scanner.Text()
-		logName = strings.TrimSuffix(logName, ".log")
-		logName = strings.ReplaceAll(logName, "\\", " ")
-		// Получаем информацию о файле
-		fileInfo, err := os.Stat(logFullPath)
-		// Пропускаем файлы, к которым нет доступа
-		if err != nil {
-			continue
+		if err != nil && !app.testMode {
+			app.showLogError("\033[31mError getting journald logs:", err, "\033[0m")
+			return
 		}
-		// Пропускаем пустые файлы
-		if fileInfo.Size() == 0 {
-			continue
+		if err != nil && app.testMode {
+			log.Print("Error: getting journald logs.", err)
 		}
-		// Получаем дату изменения
-		modTime := fileInfo.ModTime()
-		// Форматирование даты в формат DD.MM.YYYY HH:MM
-		formattedDate := modTime.Format("02.01.2006 15:04")
-		// Проверяем, что полного пути до файла еще нет в списке
-		if logName != "" && !serviceMap[logFullPath] {
-			// Добавляем путь в массив для проверки уникальных путей
-			serviceMap[logFullPath] = true
-			// Добавляем в список
-			app.logfiles = append(app.logfiles, Logfile{
-				name: "[" + "\033[34m" + formattedDate + "\033[0m" + "] " + logName,
-				path: logFullPath,
-			})
+		if !updated {
+			return
 		}
 	}
-	// Сортируем по дате
-	sort.Slice(app.logfiles, func(i, j int) bool {
-		layout := "02.01.2006 15:04"
-		dateI, _ := time.Parse(layout, extractDate(app.logfiles[i].name))
-		dateJ, _ := time.Parse(layout, extractDate(app.logfiles[j].name))
-		return dateI.After(dateJ)
-	})
-	if !app.testMode {
-		app.logfilesNotFilter = app.logfiles
-		app.applyFilterList()
-		v, err := app.gui.View("varLogs")
-		if err == nil {
-			curTime := time.Now().Format("02.01.2006 15:04:05")
-			v.Subtitle = "[ " + curTime + " ]"
-		}
+	// Сохраняем строки журнала в массив (записи journald сохраняются при чтении журнала)
+	if app.getOS == "windows" || selectUnits == "coredumps" || (selectUnits == "kernelBoot" && len(app.journaldLogs.marked) == 2) {
+		app.currentLogRecords = parseLogRecords(strings.Split(string(output), "\n"))
 	}
+	// Очищаем поле ввода для фильтрации, что бы не применять фильтрацию к новому журналу
+	// app.filterText = ""
+	app.showLogLines(newUpdate)
 }
 
-// Функция для извлечения первой втречающейся даты в формате DD.MM.YYYY HH:MM
-func extractDate(name string) string {
-	re := regexp.MustCompile(`\d{2}\.\d{2}\.\d{4}\s\d{2}:\d{2}`)
-	return re.FindString(name)
+// Время, номера PID и счетчики событий аудита, которые отличаются между загрузками и исключаются при сравнении сообщений ядра
+var kernelBootNormalizeRegex = []struct {
+	regex   *regexp.Regexp
+	replace string
+}{
+	{regexp.MustCompile(`\[\s*\d+\.\d+\]`), "[*]"},
+	{regexp.MustCompile(`audit\(\d+\.\d+:\d+\)`), "audit(*)"},
+	{regexp.MustCompile(`\b\d{1,2}:\d{2}:\d{2}(?:\.\d+)?\b`), "*"},
+	{regexp.MustCompile(`(?i)\b(pid|tid|ppid)([=: ]\s*)\d+`), "$1$2*"},
+	{regexp.MustCompile(`([\w./-]+)\[\d+\]`), "$1[*]"},
 }
 
-func (app *App) updateLogsList() {
-	v, err := app.gui.View("varLogs")
-	if err != nil {
-		return
-	}
-	v.Clear()
-	visibleEnd := min(app.startFiles+app.maxVisibleFiles, len(app.logfiles))
-	for i := app.startFiles; i < visibleEnd; i++ {
-		fmt.Fprintln(v, app.logfiles[i].name)
+// Функция для нормализации сообщения ядра перед сравнением загрузок
+func normalizeKernelMessage(message string) string {
+	message = strings.TrimSpace(message)
+	for _, normalize := range kernelBootNormalizeRegex {
+		message = normalize.regex.ReplaceAllString(message, normalize.replace)
 	}
+	return message
 }
 
-func (app *App) nextFileName(v *gocui.View, step int) error {
-	_, viewHeight := v.Size()
-	app.maxVisibleFiles = viewHeight
-	if len(app.logfiles) == 0 {
-		return nil
+// Функция для сравнения сообщений ядра двух загрузок: выводятся сообщения поздней загрузки с отметкой новых (+),
+// после них сообщения ранней загрузки, которые отсутствуют в поздней (-)
+func diffKernelBoots(earlier, later []string) []string {
+	counts := make(map[string]int)
+	for _, line := range earlier {
+		if message := normalizeKernelMessage(line); message != "" {
+			counts[message]++
+		}
 	}
-	if app.selectedFile < len(app.logfiles)-1 {
-		app.selectedFile += step
-		if app.selectedFile >= len(app.logfiles) {
-			app.selectedFile = len(app.logfiles) - 1
+	var lines []string
+	var added int
+	for _, line := range later {
+		message := normalizeKernelMessage(line)
+		switch {
+		case message == "":
+			continue
+		case counts[message] > 0:
+			counts[message]--
+			lines = append(lines, "  "+message)
+		default:
+			added++
+			lines = append(lines, "\033[32m+ "+message+"\033[0m")
 		}
-		if app.selectedFile >= app.startFiles+app.maxVisibleFiles {
-			app.startFiles += step
-			if app.startFiles > len(app.logfiles)-app.maxVisibleFiles {
-				app.startFiles = len(app.logfiles) - app.maxVisibleFiles
+	}
+	var missing []string
+	for _, line := range earlier {
+		message := normalizeKernelMessage(line)
+		if counts[message] > 0 {
+			counts[message]--
+			missing = append(missing, "\033[31m- "+message+"\033[0m")
+		}
+	}
+	lines = append(lines, "", fmt.Sprintf("\033[35m⎯⎯⎯\033[0m Missing in the later boot: %d, new in the later boot: %d \033[35m⎯⎯⎯\033[0m", len(missing), added), "")
+	return append(lines, missing...)
+}
+
+// Функция для загрузки и сравнения сообщений ядра двух отмеченных загрузок (ранняя загрузка определяется по дате в названии)
+func (app *App) loadKernelBootDiff(ctx context.Context, marked []string) ([]byte, error) {
+	boots := slices.Clone(marked)
+	slices.SortFunc(boots, func(a, b string) int {
+		return parseDateFromName(a).Compare(parseDateFromName(b))
+	})
+	var messages [2][]string
+	for i, name := range boots {
+		var bootId string
+		for _, journal := range app.journals {
+			if removeANSI(journal.name) == name {
+				bootId = journal.boot_id
+				break
 			}
-			app.updateLogsList()
 		}
-		if app.selectedFile < app.startFiles+app.maxVisibleFiles {
-			return app.selectFileByIndex(app.selectedFile - app.startFiles)
+		if bootId == "" {
+			return nil, fmt.Errorf("boot %s not found", name)
+		}
+		// Загрузки сравниваются полностью без ограничения количества строк
+		output, err := app.runCommandContext(ctx, 0,
+			"Reading logs from kernel boot for comparison",
+			"journalctl", append(app.journalSourceArgs(), "-k", "-b", bootId, "--no-pager", "--output=cat")...,
+		)
+		if err != nil {
+			return nil, err
 		}
+		messages[i] = strings.Split(string(output), "\n")
 	}
-	return nil
+	header := "\033[35m⎯⎯⎯\033[0m Kernel messages of " + boots[1] + " compared with " + boots[0] + " \033[35m⎯⎯⎯\033[0m\n\n"
+	return []byte(header + strings.Join(diffKernelBoots(messages[0], messages[1]), "\n") + "\n"), nil
 }
 
-func (app *App) prevFileName(v *gocui.View, step int) error {
-	_, viewHeight := v.Size()
-	app.maxVisibleFiles = viewHeight
-	if len(app.logfiles) == 0 {
-		return nil
-	}
-	if app.selectedFile > 0 {
-		app.selectedFile -= step
-		if app.selectedFile < 0 {
-			app.selectedFile = 0
+// Функция для чтения журнала journald (при обновлении вывода с теми же аргументами читаются только записи после курсора последней загруженной записи)
+func (app *App) readJournal(ctx context.Context, action string, args []string, newUpdate bool) (bool, error) {
+	source := &app.journaldLogs
+	queryArgs := strings.Join(args, " ")
+	incremental := !newUpdate && source.cursor != "" && source.cursorArgs == queryArgs
+	commandArgs := slices.Clone(args)
+	if incremental {
+		commandArgs = append(commandArgs, "--after-cursor="+source.cursor)
+	}
+	// Курсор записей в формате JSON передается в поле __CURSOR
+	jsonOutput := slices.Contains(args, "--output=json")
+	if !jsonOutput {
+		commandArgs = append(commandArgs, "--show-cursor")
+	}
+	output, err := app.runCommandContext(ctx, 0, action, "journalctl", commandArgs...)
+	if ctx.Err() != nil || (err != nil && !app.testMode) {
+		return false, err
+	}
+	var records []LogRecord
+	var cursor string
+	if jsonOutput {
+		records = parseJournalJSON(output)
+		for i := len(records) - 1; i >= 0 && cursor == ""; i-- {
+			cursor = records[i].fields["__CURSOR"]
+		}
+		if len(source.marked) > 1 {
+			app.setRecordOrigins(records, source.units, source.marked)
 		}
-		if app.selectedFile < app.startFiles {
-			app.startFiles -= step
-			if app.startFiles < 0 {
-				app.startFiles = 0
+	} else {
+		lines := strings.Split(string(output), "\n")
+		// Курсор последней записи выводится в последней строке
+		for i := len(lines) - 1; i >= 0; i-- {
+			if value, found := strings.CutPrefix(lines[i], "-- cursor: "); found {
+				cursor = value
+				lines = slices.Delete(lines, i, i+1)
+				break
+			}
+			if lines[i] != "" {
+				break
 			}
-			app.updateLogsList()
 		}
-		if app.selectedFile >= app.startFiles {
-			return app.selectFileByIndex(app.selectedFile - app.startFiles)
+		records = parseLogRecords(lines)
+	}
+	switch {
+	case cursor != "":
+		source.cursor = cursor
+		source.cursorArgs = queryArgs
+	case !incremental:
+		source.cursor = ""
+	}
+	if !incremental {
+		app.currentLogRecords = records
+		return true, err
+	}
+	records = slices.DeleteFunc(trimEmptyRecords(records), func(r LogRecord) bool {
+		return r.text() == "-- No entries --"
+	})
+	if len(records) == 0 {
+		return false, err
+	}
+	// Новые записи добавляются после делимитра (делимитр добавляется один раз после загрузки журнала)
+	currentRecords := trimEmptyRecords(app.currentLogRecords)
+	if !slices.ContainsFunc(currentRecords, isDelimiterRecord) {
+		currentRecords = append(currentRecords, LogRecord{format: delimiterRecord})
+	}
+	currentRecords = append(currentRecords, records...)
+	if count, convErr := strconv.Atoi(app.logViewCount); convErr == nil && len(currentRecords) > count {
+		currentRecords = currentRecords[len(currentRecords)-count:]
+	}
+	app.currentLogRecords = append(currentRecords, LogRecord{})
+	return true, err
+}
+
+// Функция для указания журнала в записях объединенного вывода (продолжения многострочных сообщений наследуют журнал записи)
+func (app *App) setRecordOrigins(records []LogRecord, units string, marked []string) {
+	var origin string
+	for i := range records {
+		if records[i].fields != nil {
+			origin = app.journalOrigin(records[i], units, marked)
+		}
+		if records[i].fields != nil || records[i].message != "" {
+			records[i].origin = origin
 		}
 	}
-	return nil
 }
 
-func (app *App) selectFileByIndex(index int) error {
-	v, err := app.gui.View("varLogs")
-	if err != nil {
-		return err
-	}
-	// Обновляем счетчик в заголовке
-	re := regexp.MustCompile(`\s\(.+\) >`)
-	updateTitle := " (0) >"
-	if len(app.logfiles) != 0 {
-		updateTitle = " (" + strconv.Itoa(app.selectedFile+1) + "/" + strconv.Itoa(len(app.logfiles)) + ") >"
+// Функция для определения отмеченного журнала, к которому относится запись (по полям, которые проверяет journalctl при фильтрации)
+func (app *App) journalOrigin(record LogRecord, units string, marked []string) string {
+	var names []string
+	switch units {
+	case "systemJournals":
+		for _, name := range marked {
+			if isJournalMatch(name) && journalMatchRecord(name, record.fields) {
+				return name
+			}
+		}
+		names = []string{app.journalField}
+	case "userUnits":
+		names = []string{"_SYSTEMD_USER_UNIT", "USER_UNIT", "OBJECT_SYSTEMD_USER_UNIT", "COREDUMP_USER_UNIT"}
+	default:
+		names = []string{"_SYSTEMD_UNIT", "UNIT", "OBJECT_SYSTEMD_UNIT", "COREDUMP_UNIT"}
 	}
-	v.Title = re.ReplaceAllString(v.Title, updateTitle)
-	if err := v.SetCursor(0, index); err != nil {
-		return nil
+	for _, name := range names {
+		if value := record.fields[name]; value != "" && slices.Contains(marked, value) {
+			return value
+		}
 	}
-	return nil
+	return record.fields[names[0]]
 }
 
-func (app *App) selectFile(g *gocui.Gui, v *gocui.View) error {
-	if v == nil || len(app.logfiles) == 0 {
-		return nil
-	}
-	_, cy := v.Cursor()
-	line, err := v.Line(cy)
-	if err != nil {
-		return err
-	}
-	if app.fastMode {
-		go func() {
-			app.loadFileLogs(strings.TrimSpace(line), true)
-		}()
-	} else {
-		app.loadFileLogs(strings.TrimSpace(line), true)
+// Функция для разбора вывода journalctl в формате JSON в записи журнала (с завершающей пустой записью, как при разборе строк)
+func parseJournalJSON(output []byte) []LogRecord {
+	var records []LogRecord
+	for line := range strings.SplitSeq(string(output), "\n") {
+		records = append(records, journalRecords(line)...)
 	}
-	app.lastWindow = "varLogs"
-	app.lastSelected = strings.TrimSpace(line)
-	return nil
+	return append(records, LogRecord{})
 }
 
-// Функция для чтения файла
-func (app *App) loadFileLogs(logName string, newUpdate bool) {
-	app.lastContainerizationSystem = ""
-	if logName == "" {
+// Функция для получения списка юнитов из offline журнала по полям записей (статус работы недоступен без systemctl)
+func (app *App) loadJournalUnits(journalName string) {
+	unitField := "_SYSTEMD_UNIT"
+	logSource := "Loading the system units"
+	if journalName == "userUnits" {
+		unitField = "_SYSTEMD_USER_UNIT"
+		logSource = "Loading the user units"
+	}
+	output, err := app.runCommand(logSource, "journalctl", append(app.journalSourceArgs(), "--no-pager", "--field="+unitField)...)
+	if err != nil && !app.testMode {
+		vError, _ := app.gui.View("services")
+		vError.Clear()
+		app.journalListFrameColor = app.errorColor
+		vError.FrameColor = app.journalListFrameColor
+		vError.Highlight = false
+		fmt.Fprintln(vError, "\033[31mError getting units from journal "+strings.Join(app.journalSourceArgs(), " ")+"\033[0m")
 		return
 	}
-	app.debugStartTime = time.Now()
-	// В параметре logName имя файла при выборе возвращяется без символов покраски
-	// Получаем путь из массива по имени
-	var logFullPath string
-	for _, logfile := range app.logfiles {
-		// Удаляем покраску из имени файла в сохраненном массиве
-		logFileName := ansiEscape.ReplaceAllString(logfile.name, "")
-		// Ищем переданное в функцию имя файла и извлекаем путь
-		if logFileName == logName {
-			logFullPath = logfile.path
-			break
-		}
+	if err != nil && app.testMode {
+		log.Print("Error: getting units from journal " + strings.Join(app.journalSourceArgs(), " "))
 	}
-	// Обновляем статус с названием источника журнала (полный путь к файлу)
 	if !app.testMode {
-		v, err := app.gui.View("logs")
-		if err == nil {
-			v.Subtitle = "[ " + logFullPath + " ]"
+		v, _ := app.gui.View("services")
+		app.journalListFrameColor = app.frameColor
+		if v.FrameColor != app.frameColor {
+			v.FrameColor = app.selectedFrameColor
 		}
+		v.Highlight = true
 	}
-	if newUpdate {
-		app.lastLogPath = logFullPath
-		// Фиксируем новую дату изменения и размер для выбранного файла
-		fileInfo, err := app.statFile(logFullPath)
+	// Оставляем только юниты выбранных типов (service,timer,scope,socket,mount)
+	unitTypes := strings.Split(app.unitType, ",")
+	var units []string
+	unitMap := make(map[string]bool)
+	for line := range strings.SplitSeq(string(output), "\n") {
+		unitName := strings.TrimSpace(line)
+		if unitName == "" || unitMap[unitName] {
+			continue
+		}
+		unitMap[unitName] = true
+		if slices.ContainsFunc(unitTypes, func(unitType string) bool {
+			return strings.HasSuffix(unitName, "."+strings.TrimSpace(unitType))
+		}) {
+			units = append(units, unitName)
+		}
+	}
+	sort.Strings(units)
+	for _, unitName := range units {
+		app.journals = append(app.journals, Journal{
+			name:    unitName,
+			boot_id: unitName,
+		})
+	}
+}
+
+// Функция для формирования аргументов источника journalctl при чтении offline журнала из каталога или файла
+func (app *App) journalSourceArgs() []string {
+	if app.journalPath == "" {
+		// Пространство имен journald используется только для журнала текущей системы
+		if app.journalNamespace != "" {
+			return []string{"--namespace=" + app.journalNamespace}
+		}
+		return nil
+	}
+	// Файлы журнала (в том числе шаблон файлов) передаются через --file, остальные пути считаются каталогом
+	if strings.HasSuffix(app.journalPath, ".journal") || strings.HasSuffix(app.journalPath, ".journal~") {
+		return []string{"--file=" + app.journalPath}
+	}
+	return []string{"--directory=" + app.journalPath}
+}
+
+// Функция для проверки, что юниты читаются из полей журнала (offline журнал или пространство имен), а не через systemctl
+func (app *App) journalFieldUnits() bool {
+	return app.journalPath != "" || app.journalNamespace != ""
+}
+
+// Функция для отображения пространства имен journald в статусе
+func (app *App) journalNamespaceStatus() string {
+	if app.journalNamespace == "" {
+		return "default"
+	}
+	return app.journalNamespace
+}
+
+// Функция для получения списка пространств имен journald по каталогам журналов <machine-id>.<namespace>
+func (app *App) journalNamespaces() []string {
+	var names []string
+	if app.sshMode {
+		output, err := app.runCommand("Loading the journal namespaces", "ls", "-1", "/var/log/journal")
 		if err != nil {
-			return
+			return nil
 		}
-		fileModTime := fileInfo.ModTime()
-		fileSize := fileInfo.Size()
-		app.lastDateUpdateFile = fileModTime
-		app.lastSizeFile = fileSize
-		app.updateFile = true
+		names = strings.Split(string(output), "\n")
 	} else {
-		logFullPath = app.lastLogPath
-		// Проверяем дату изменения
-		fileInfo, err := app.statFile(logFullPath)
+		entries, err := os.ReadDir("/var/log/journal")
 		if err != nil {
-			return
+			return nil
 		}
-		fileModTime := fileInfo.ModTime()
-		fileSize := fileInfo.Size()
-		// Обновлять файл в горутине, только если есть изменения (проверяем дату модификации и размер)
-		if fileModTime != app.lastDateUpdateFile || fileSize != app.lastSizeFile {
-			app.lastDateUpdateFile = fileModTime
-			app.lastSizeFile = fileSize
-			app.updateFile = true
+		for _, entry := range entries {
+			if entry.IsDir() {
+				names = append(names, entry.Name())
+			}
+		}
+	}
+	return parseJournalNamespaces(names)
+}
+
+// Функция для извлечения названий пространств имен из названий каталогов журналов
+func parseJournalNamespaces(names []string) []string {
+	var namespaces []string
+	for _, name := range names {
+		machineId, namespace, found := strings.Cut(strings.TrimSpace(name), ".")
+		// Каталог без суффикса относится к пространству имен по умолчанию
+		if !found || len(machineId) != 32 || namespace == "" || slices.Contains(namespaces, namespace) {
+			continue
+		}
+		namespaces = append(namespaces, namespace)
+	}
+	sort.Strings(namespaces)
+	return namespaces
+}
+
+// Функция для отображения источника journald в статусе (false или путь к offline журналу)
+func (app *App) journalPathStatus() string {
+	if app.journalPath == "" {
+		return "false"
+	}
+	return app.journalPath
+}
+
+// Функция для формирования аргументов фильтрации journalctl по юнитам или полю, загрузке и приоритету
+func (app *App) journalFilterArgs(selectUnits string, serviceNames ...string) []string {
+	args := app.journalSourceArgs()
+	// #46 Добавляем аргумент для пользовательских журналов
+	// (в offline журнале пользовательские юниты фильтруются по полю, так как --user относится к текущему пользователю)
+	if selectUnits == "userUnits" && !app.journalFieldUnits() {
+		args = append(args, "--user")
+	}
+	// Фильтрация по юниту (unit) или полю (field), несколько условий объединяются journalctl через ИЛИ
+	var lastMatch bool
+	for i, serviceName := range serviceNames {
+		match := selectUnits == "systemJournals" && isJournalMatch(serviceName) ||
+			selectUnits == "userUnits" && app.journalFieldUnits()
+		// Условия построителя и пользовательских юнитов объединяются с другими журналами через дизъюнкцию (+)
+		if i > 0 && (match || lastMatch) {
+			args = append(args, "+")
+		}
+		lastMatch = match
+		switch {
+		case serviceName == "_all":
+		case selectUnits == "systemJournals" && match:
+			args = append(args, journalMatchTerms(serviceName)...)
+		case selectUnits == "systemJournals":
+			args = append(args, app.journalField+"="+serviceName)
+		case match:
+			// --user-unit добавляет условие _UID текущего пользователя, поэтому в offline журнале юнит фильтруется по полям
+			args = append(args, "_SYSTEMD_USER_UNIT="+serviceName, "+", "USER_UNIT="+serviceName)
+		default:
+			args = append(args, "--unit="+serviceName)
+		}
+	}
+	// Фильтрация по порядковому номеру загрузки системы (boot)
+	args = append(args, "--boot="+app.journalBoot)
+	// Фильтрация по приоритету
+	args = append(args, "--priority="+app.journalPriority)
+	// Фильтрация по регулярному выражению на стороне journalctl (ограничение количества строк применяется после поиска)
+	args = append(args, app.journalGrepArgs()...)
+	return args
+}
+
+// Условие journald в формате FIELD=value
+var journalMatchTermRegex = regexp.MustCompile(`^[A-Z0-9_]+=`)
+
+// Функция для разбора выражения journald на условия (значения с пробелами записываются в кавычках: FIELD="a  b FOO=c")
+func journalMatchTerms(expr string) []string {
+	var terms []string
+	for rest := strings.TrimSpace(expr); rest != ""; rest = strings.TrimLeftFunc(rest, unicode.IsSpace) {
+		field := journalMatchTermRegex.FindString(rest)
+		// Значение в кавычках читается целиком вместе с пробелами
+		if field != "" && strings.HasPrefix(rest[len(field):], `"`) {
+			if quoted, err := strconv.QuotedPrefix(rest[len(field):]); err == nil {
+				value, _ := strconv.Unquote(quoted)
+				terms = append(terms, field+value)
+				rest = rest[len(field)+len(quoted):]
+				continue
+			}
+		}
+		word := rest
+		if i := strings.IndexFunc(rest, unicode.IsSpace); i >= 0 {
+			word = rest[:i]
+		}
+		rest = rest[len(word):]
+		// Слова без поля относятся к значению предыдущего условия (выражения, сохраненные без кавычек)
+		if word == "+" || field != "" || len(terms) == 0 {
+			terms = append(terms, word)
 		} else {
-			app.updateFile = false
+			terms[len(terms)-1] += " " + word
 		}
 	}
-	// Читаем файл, толькое если были изменения
-	if app.updateFile {
-		// Читаем логи в системе Windows
-		if app.getOS == "windows" {
-			decodedOutput, stringErrors := app.loadWinFileLog(logFullPath)
-			if stringErrors != "nil" && !app.testMode {
-				v, _ := app.gui.View("logs")
-				v.Clear()
-				fmt.Fprintln(v, "\033[31mError", stringErrors, "\033[0m")
-				return
+	return terms
+}
+
+// Функция для формирования выражения journald из условий (значения с пробелами и кавычками записываются в кавычках)
+func journalMatchExpr(terms []string) string {
+	words := make([]string, 0, len(terms))
+	for _, term := range terms {
+		field, value, found := strings.Cut(term, "=")
+		if found && term != "+" && (strings.ContainsFunc(value, unicode.IsSpace) || strings.HasPrefix(value, `"`)) {
+			term = field + "=" + strconv.Quote(value)
+		}
+		words = append(words, term)
+	}
+	return strings.Join(words, " ")
+}
+
+// Функция для проверки, что название журнала является выражением из условий journald (FIELD=value и +)
+func isJournalMatch(name string) bool {
+	terms := journalMatchTerms(name)
+	if len(terms) == 0 {
+		return false
+	}
+	for _, term := range terms {
+		if term != "+" && !journalMatchTermRegex.MatchString(term) {
+			return false
+		}
+	}
+	return true
+}
+
+// Функция для проверки записи на соответствие выражению journald
+// (условия для одного поля объединяются через ИЛИ, для разных полей через И, группы через + как ИЛИ)
+func journalMatchRecord(expr string, fields map[string]string) bool {
+	group := make(map[string][]string)
+	matchGroup := func() bool {
+		if len(group) == 0 {
+			return false
+		}
+		for field, values := range group {
+			if !slices.Contains(values, fields[field]) {
+				return false
 			}
-			if stringErrors != "nil" && app.testMode {
-				log.Print("Error: ", stringErrors)
+		}
+		return true
+	}
+	for _, term := range journalMatchTerms(expr) {
+		if term == "+" {
+			if matchGroup() {
+				return true
 			}
-			app.currentLogLines = strings.Split(string(decodedOutput), "\n")
-		} else {
-			var cmd *exec.Cmd
-			// Читаем логи в системах UNIX (Linux/Darwin/*BSD)
+			clear(group)
+			continue
+		}
+		field, value, _ := strings.Cut(term, "=")
+		group[field] = append(group[field], value)
+	}
+	return matchGroup()
+}
+
+// Функция для формирования аргументов поиска journalctl по сообщению в режиме фильтрации regex
+func (app *App) journalGrepArgs() []string {
+	if app.selectFilterMode != "regex" || app.filterText == "" {
+		return nil
+	}
+	// Выражение проверяет journalctl (PCRE2), ошибка выводится в окне вывода при загрузке журнала
+	return []string{"--grep=" + app.filterText, "--case-sensitive=no"}
+}
+
+// Функция для проверки, что журнал загружается journalctl с поиском --grep (события аудита, дампы, сравнение загрузок и события Windows читаются без него)
+func (s *journaldSource) grepSupported(app *App) bool {
+	switch {
+	case app.getOS == "windows" || s.units == "auditd" || s.units == "coredumps":
+		return false
+	case s.units == "kernelBoot" && len(s.marked) == 2:
+		return false
+	}
+	return true
+}
+
+// Функция для проверки, что записи текущего журнала уже отфильтрованы journalctl --grep по текущему выражению
+func (app *App) journalGrepApplied() bool {
+	_, ok := app.currentSource.(*journaldSource)
+	return ok && app.journaldLogs.grep != "" && app.journaldLogs.grep == strings.Join(app.journalGrepArgs(), " ")
+}
+
+// Функция для перезагрузки журнала journald при изменении фильтра regex (с задержкой, чтобы не запускать поиск на каждый символ ввода)
+func (app *App) scheduleJournalGrep() {
+	if _, ok := app.currentSource.(*journaldSource); !ok || app.testMode || !app.journaldLogs.grepSupported(app) {
+		return
+	}
+	// Журнал уже загружен с текущим выражением
+	if strings.Join(app.journalGrepArgs(), " ") == app.journaldLogs.grep {
+		return
+	}
+	if app.journalGrepTimer != nil {
+		app.journalGrepTimer.Stop()
+	}
+	app.journalGrepTimer = time.AfterFunc(500*time.Millisecond, func() {
+		app.updateLogOutput(false)
+	})
+}
+
+// Функция для чтения и парсинга содержимого события Windows через wevtutil
+func (app *App) loadWinEventLog(eventName string) (output []byte) {
+	app.lastContainerizationSystem = ""
+	if eventName == "" {
+		return []byte("")
+	}
+	eventData, _ := app.localCmdExecutor().Output(context.Background(), Command{
+		Name: "powershell",
+		Args: []string{"-Command",
+			"wevtutil qe " + eventName + " /f:text -l:en /c:" + app.logViewCount +
+				" /q:'*[System[TimeCreated[timediff(@SystemTime) <= 2592000000]]]'",
+		},
+		Action: "Reading logs from windows event",
+	})
+	// Декодирование вывода из Windows-1251 в UTF-8
+	decoder := charmap.Windows1251.NewDecoder()
+	decodeEventData, decodeErr := decoder.Bytes(eventData)
+	if decodeErr == nil {
+		eventData = decodeEventData
+	}
+	// Разбиваем вывод на массив
+	eventStrings := strings.Split(string(eventData), "Event[")
+	var eventMessage []string
+	for _, eventString := range eventStrings {
+		var dateTime, eventID, level, description string
+		// Разбиваем элемент массива на строки
+		lines := strings.Split(eventString, "\n")
+		// Флаг для обработки последней строки Description с содержимым Message
+		isDescription := false
+		for _, line := range lines {
+			// Удаляем проблемы во всех строках
+			trimmedLine := strings.TrimSpace(line)
 			switch {
-			// Читаем файлы в формате ASL (Apple System Log)
-			case strings.HasSuffix(logFullPath, "asl"):
-				if app.sshMode {
-					cmd = exec.Command(
-						"ssh", append(app.sshOptions,
-							"syslog", "-f", logFullPath,
-						)...)
-				} else {
-					cmd = exec.Command(
-						"syslog", "-f", logFullPath,
-					)
-				}
-				if app.logging {
-					slog.Info(cmd.String(), "action", "Reading logs in asl format")
-				}
-				output, err := cmd.Output()
-				if err != nil && !app.testMode {
-					v, _ := app.gui.View("logs")
-					v.Clear()
-					fmt.Fprintln(v, " \033[31mError reading log using syslog tool in ASL (Apple System Log) format.\n", err, "\033[0m")
-					return
-				}
-				if err != nil && app.testMode {
-					log.Print("Error: reading log using syslog tool in ASL (Apple System Log) format. ", err)
-				}
-				app.currentLogLines = strings.Split(string(output), "\n")
-			// Читаем журналы Packet Capture в формате pcap/pcapng
-			case strings.HasSuffix(logFullPath, "pcap") || strings.HasSuffix(logFullPath, "pcapng"):
-				if app.sshMode {
-					cmd = exec.Command(
-						"ssh", append(app.sshOptions,
-							"tcpdump", "-n", "-r", logFullPath,
-						)...)
-				} else {
-					cmd = exec.Command(
-						"tcpdump", "-n", "-r", logFullPath,
-					)
-				}
-				if app.logging {
-					slog.Info(cmd.String(), "action", "Reading logs in pcap format")
-				}
-				output, err := cmd.Output()
-				if err != nil && !app.testMode {
-					v, _ := app.gui.View("logs")
-					v.Clear()
-					fmt.Fprintln(v, " \033[31mError reading log using tcpdump tool.\n", err, "\033[0m")
-					return
-				}
-				if err != nil && app.testMode {
-					log.Print("Error: reading log using tcpdump tool. ", err)
-				}
-				app.currentLogLines = strings.Split(string(output), "\n")
-			// Packet Filter (PF) Firewall (OpenBSD)
-			case strings.HasSuffix(logFullPath, "pflog"):
-				if app.sshMode {
-					cmd = exec.Command(
-						"ssh", append(app.sshOptions,
-							"tcpdump", "-e", "-n", "-r", logFullPath,
-						)...)
-				} else {
-					cmd = exec.Command(
-						"tcpdump", "-e", "-n", "-r", logFullPath,
-					)
-				}
-				if app.logging {
-					slog.Info(cmd.String(), "action", "Reading logs in pflog format")
-				}
-				output, err := cmd.Output()
-				if err != nil && !app.testMode {
-					v, _ := app.gui.View("logs")
-					v.Clear()
-					fmt.Fprintln(v, " \033[31mError reading log using tcpdump tool.\n", err, "\033[0m")
-					return
+			// Обновляем формат даты
+			case strings.HasPrefix(trimmedLine, "Date:"):
+				dateTime = strings.ReplaceAll(trimmedLine, "Date: ", "")
+				dateTimeParse := strings.Split(dateTime, "T")
+				dateParse := strings.Split(dateTimeParse[0], "-")
+				timeParse := strings.Split(dateTimeParse[1], ".")
+				dateTime = fmt.Sprintf("%s.%s.%s %s", dateParse[2], dateParse[1], dateParse[0], timeParse[0])
+			case strings.HasPrefix(trimmedLine, "Event ID:"):
+				eventID = strings.ReplaceAll(trimmedLine, "Event ID: ", "")
+			case strings.HasPrefix(trimmedLine, "Level:"):
+				level = strings.ReplaceAll(trimmedLine, "Level: ", "")
+			case strings.HasPrefix(trimmedLine, "Description:"):
+				// Фиксируем и пропускаем Description
+				isDescription = true
+			case isDescription:
+				// Добавляем до конца текущего массива все не пустые строки
+				if trimmedLine != "" {
+					description += "\n" + trimmedLine
 				}
-				app.currentLogLines = strings.Split(string(output), "\n")
-			// Читаем архивные логи в формате pcap/pcapng (macOS)
-			case strings.HasSuffix(logFullPath, "pcap.gz") || strings.HasSuffix(logFullPath, "pcapng.gz"):
-				var unpacker = "gzip"
-				// Создаем временный файл
-				tmpFile, err := os.CreateTemp("", "temp-*.pcap")
-				if err != nil && !app.testMode {
-					vError, _ := app.gui.View("logs")
-					vError.Clear()
-					fmt.Fprintln(vError, " \033[31mError create temp file.\n", err, "\033[0m")
-					return
-				}
-				// Удаляем временный файл после обработки
-				defer os.Remove(tmpFile.Name())
-				var cmdUnzip *exec.Cmd
-				if app.sshMode {
-					cmdUnzip = exec.Command(
-						"ssh", append(app.sshOptions,
-							unpacker, "-dc", logFullPath,
-						)...)
-				} else {
-					cmdUnzip = exec.Command(
-						unpacker, "-dc", logFullPath,
-					)
-				}
-				cmdUnzip.Stdout = tmpFile
-				if err := cmdUnzip.Start(); err != nil && !app.testMode {
-					vError, _ := app.gui.View("logs")
-					vError.Clear()
-					fmt.Fprintln(vError, " \033[31mError starting", unpacker, "tool.\n", err, "\033[0m")
-					return
-				}
-				if err := cmdUnzip.Wait(); err != nil && !app.testMode {
-					vError, _ := app.gui.View("logs")
-					vError.Clear()
-					fmt.Fprintln(vError, " \033[31mError decompressing file with", unpacker, "tool.\n", err, "\033[0m")
-					return
-				}
-				// Закрываем временный файл, чтобы tcpdump мог его открыть
-				if err := tmpFile.Close(); err != nil && !app.testMode {
-					vError, _ := app.gui.View("logs")
-					vError.Clear()
-					fmt.Fprintln(vError, " \033[31mError closing temp file.\n", err, "\033[0m")
-					return
-				}
-				// Создаем команду для tcpdump
-				var cmdTcpdump *exec.Cmd
-				if app.sshMode {
-					cmdTcpdump = exec.Command(
-						"ssh", append(app.sshOptions,
-							"tcpdump", "-n", "-r", tmpFile.Name(),
-						)...)
-				} else {
-					cmdTcpdump = exec.Command(
-						"tcpdump", "-n", "-r", tmpFile.Name(),
-					)
-				}
-				if app.logging {
-					slog.Info(cmd.String(), "action", "Reading logs in pcap format")
-				}
-				tcpdumpOut, err := cmdTcpdump.StdoutPipe()
-				if err != nil && !app.testMode {
-					vError, _ := app.gui.View("logs")
-					vError.Clear()
-					fmt.Fprintln(vError, " \033[31mError creating stdout pipe for tcpdump.\n", err, "\033[0m")
-					return
-				}
-				// Запускаем tcpdump
-				if err := cmdTcpdump.Start(); err != nil && !app.testMode {
-					vError, _ := app.gui.View("logs")
-					vError.Clear()
-					fmt.Fprintln(vError, " \033[31mError starting tcpdump.\n", err, "\033[0m")
-					return
-				}
-				// Читаем вывод tcpdump построчно
-				scanner := bufio.NewScanner(tcpdumpOut)
-				var lines []string
-				for scanner.Scan() {
-					lines = append(lines, scanner.Text())
-				}
-				if err := scanner.Err(); err != nil && !app.testMode {
-					vError, _ := app.gui.View("logs")
-					vError.Clear()
-					fmt.Fprintln(vError, " \033[31mError reading output from tcpdump.\n", err, "\033[0m")
-					return
-				}
-				// Ожидаем завершения tcpdump
-				if err := cmdTcpdump.Wait(); err != nil && !app.testMode {
-					vError, _ := app.gui.View("logs")
-					vError.Clear()
-					fmt.Fprintln(vError, " \033[31mError finishing tcpdump.\n", err, "\033[0m")
-					return
-				}
-				app.currentLogLines = lines
-			// Читаем архивные логи (unpack + stdout) в формате: gz/xz/bz2
-			case strings.HasSuffix(logFullPath, ".gz") || strings.HasSuffix(logFullPath, ".xz") || strings.HasSuffix(logFullPath, ".bz2"):
-				var unpacker string
-				switch {
-				case strings.HasSuffix(logFullPath, ".gz"):
-					unpacker = "gzip"
-				case strings.HasSuffix(logFullPath, ".xz"):
-					unpacker = "xz"
-				case strings.HasSuffix(logFullPath, ".bz2"):
-					unpacker = "bzip2"
-				}
-				var cmdUnzip *exec.Cmd
-				var cmdTail *exec.Cmd
-				if app.sshMode {
-					cmdUnzip = exec.Command(
-						"ssh", append(app.sshOptions,
-							unpacker, "-dc", logFullPath,
-						)...)
-					cmdTail = exec.Command(
-						"ssh", append(app.sshOptions,
-							"tail", "-n", app.logViewCount,
-						)...)
-				} else {
-					cmdUnzip = exec.Command(
-						unpacker, "-dc", logFullPath,
-					)
-					cmdTail = exec.Command(
-						"tail", "-n", app.logViewCount,
-					)
-				}
-				if app.logging {
-					slog.Info(cmdUnzip.String(), "action", "Reading the archive log")
-				}
-				pipe, err := cmdUnzip.StdoutPipe()
-				if err != nil && !app.testMode {
-					vError, _ := app.gui.View("logs")
-					vError.Clear()
-					fmt.Fprintln(vError, " \033[31mError creating pipe for", unpacker, "tool.\n", err, "\033[0m")
-					return
-				}
-				// Стандартный вывод программы передаем в stdin tail
-				if app.logging {
-					slog.Info(cmdTail.String(), "action", "Reading the archive log")
-				}
-				cmdTail.Stdin = pipe
-				out, err := cmdTail.StdoutPipe()
-				if err != nil && !app.testMode {
-					vError, _ := app.gui.View("logs")
-					vError.Clear()
-					fmt.Fprintln(vError, " \033[31mError creating stdout pipe for tail.\n", err, "\033[0m")
-					return
-				}
-				// Запуск команд
-				if err := cmdUnzip.Start(); err != nil && !app.testMode {
-					vError, _ := app.gui.View("logs")
-					vError.Clear()
-					fmt.Fprintln(vError, " \033[31mError starting", unpacker, "tool.\n", err, "\033[0m")
-					return
-				}
-				if err := cmdTail.Start(); err != nil && !app.testMode {
-					vError, _ := app.gui.View("logs")
-					vError.Clear()
-					fmt.Fprintln(vError, " \033[31mError starting tail from", unpacker, "stdout.\n", err, "\033[0m")
-					return
-				}
-				// Чтение вывода
-				output, err := io.ReadAll(out)
-				if err != nil && !app.testMode {
-					vError, _ := app.gui.View("logs")
-					vError.Clear()
-					fmt.Fprintln(vError, " \033[31mError reading output from tail.\n", err, "\033[0m")
-					return
-				}
-				// Ожидание завершения команд
-				if err := cmdUnzip.Wait(); err != nil && !app.testMode {
-					vError, _ := app.gui.View("logs")
-					vError.Clear()
-					fmt.Fprintln(vError, " \033[31mError reading archive log using", unpacker, "tool.\n", err, "\033[0m")
-					return
-				}
-				if err := cmdTail.Wait(); err != nil && !app.testMode {
-					vError, _ := app.gui.View("logs")
-					vError.Clear()
-					fmt.Fprintln(vError, " \033[31mError reading log using tail tool.\n", err, "\033[0m")
-					return
-				}
-				// Выводим содержимое
-				app.currentLogLines = strings.Split(string(output), "\n")
-			// Читаем бинарные файлы с помощью last для wtmp, а также utmp (OpenBSD) и utx.log (FreeBSD)
-			case strings.Contains(logFullPath, "wtmp") || strings.Contains(logFullPath, "utmp") || strings.Contains(logFullPath, "utx.log"):
-				if app.sshMode {
-					cmd = exec.Command(
-						"ssh", append(app.sshOptions,
-							"last", "-f", logFullPath,
-						)...)
-				} else {
-					cmd = exec.Command(
-						"last", "-f", logFullPath,
-					)
-				}
-				if app.logging {
-					slog.Info(cmd.String(), "action", "Reading logs in wtmp/utmp/utx format")
-				}
-				output, err := cmd.Output()
-				if err != nil && !app.testMode {
-					v, _ := app.gui.View("logs")
-					v.Clear()
-					fmt.Fprintln(v, " \033[31mError reading log using last tool.\n", err, "\033[0m")
-					return
-				}
-				// Разбиваем вывод на строки
-				lines := strings.Split(string(output), "\n")
-				var filteredLines []string
-				// Фильтруем строки, исключая последнюю строку и пустые строки
-				for _, line := range lines {
-					trimmedLine := strings.TrimSpace(line)
-					if trimmedLine != "" && !strings.Contains(trimmedLine, "begins") {
-						filteredLines = append(filteredLines, trimmedLine)
-					}
-				}
-				// Переворачиваем порядок строк
-				for i, j := 0, len(filteredLines)-1; i < j; i, j = i+1, j-1 {
-					filteredLines[i], filteredLines[j] = filteredLines[j], filteredLines[i]
-				}
-				app.currentLogLines = filteredLines
-			// lastb for btmp
-			case strings.Contains(logFullPath, "btmp"):
-				if app.sshMode {
-					cmd = exec.Command(
-						"ssh", append(app.sshOptions,
-							"lastb", "-f", logFullPath,
-						)...)
-				} else {
-					cmd = exec.Command(
-						"lastb", "-f", logFullPath,
-					)
-				}
-				if app.logging {
-					slog.Info(cmd.String(), "action", "Reading logs in btmp format")
-				}
-				output, err := cmd.Output()
-				if err != nil && !app.testMode {
-					v, _ := app.gui.View("logs")
-					v.Clear()
-					fmt.Fprintln(v, " \033[31mError reading log using lastb tool.\n", err, "\033[0m")
-					return
-				}
-				lines := strings.Split(string(output), "\n")
-				var filteredLines []string
-				for _, line := range lines {
-					trimmedLine := strings.TrimSpace(line)
-					if trimmedLine != "" && !strings.Contains(trimmedLine, "begins") {
-						filteredLines = append(filteredLines, trimmedLine)
-					}
-				}
-				for i, j := 0, len(filteredLines)-1; i < j; i, j = i+1, j-1 {
-					filteredLines[i], filteredLines[j] = filteredLines[j], filteredLines[i]
-				}
-				app.currentLogLines = filteredLines
-			// Выводим содержимое из команды lastlog
-			case strings.HasSuffix(logFullPath, "lastlog"):
-				if app.sshMode {
-					cmd = exec.Command(
-						"ssh", append(app.sshOptions,
-							"lastlog",
-						)...)
-				} else {
-					cmd = exec.Command(
-						"lastlog",
-					)
-				}
-				if app.logging {
-					slog.Info(cmd.String(), "action", "Reading logs in lastlog format")
-				}
-				output, err := cmd.Output()
-				if err != nil && !app.testMode {
-					v, _ := app.gui.View("logs")
-					v.Clear()
-					fmt.Fprintln(v, " \033[31mError reading log using lastlog tool.\n", err, "\033[0m")
-					return
-				}
-				app.currentLogLines = strings.Split(string(output), "\n")
-			// lastlogin for FreeBSD
-			case strings.HasSuffix(logFullPath, "lastlogin"):
-				if app.sshMode {
-					cmd = exec.Command(
-						"ssh", append(app.sshOptions,
-							"lastlogin",
-						)...)
-				} else {
-					cmd = exec.Command(
-						"lastlogin",
-					)
-				}
-				if app.logging {
-					slog.Info(cmd.String(), "action", "Reading logs in lastlogin format")
-				}
-				output, err := cmd.Output()
-				if err != nil && !app.testMode {
-					v, _ := app.gui.View("logs")
-					v.Clear()
-					fmt.Fprintln(v, " \033[31mError reading log using lastlogin tool.\n", err, "\033[0m")
-					return
-				}
-				app.currentLogLines = strings.Split(string(output), "\n")
-			default:
-				if app.sshMode {
-					cmd = exec.Command(
-						"ssh", append(app.sshOptions,
-							"tail", "-n", app.logViewCount, logFullPath,
-						)...)
-				} else {
-					cmd = exec.Command(
-						"tail", "-n", app.logViewCount, logFullPath,
-					)
-				}
-				if app.logging {
-					slog.Info(cmd.String(), "action", "Reading log file")
-				}
-				output, err := cmd.Output()
-				if err != nil && !app.testMode {
-					v, _ := app.gui.View("logs")
-					v.Clear()
-					fmt.Fprintln(v, " \033[31mError reading log using tail tool.\n", err, "\033[0m")
-					return
-				}
-				app.currentLogLines = strings.Split(string(output), "\n")
 			}
 		}
-		if !app.testMode {
-			app.updateDelimiter(newUpdate)
-			app.applyFilter(false)
+		if dateTime != "" && eventID != "" && level != "" && description != "" {
+			eventMessage = append(eventMessage, fmt.Sprintf("%s %s (%s): %s", dateTime, level, eventID, strings.TrimSpace(description)))
 		}
 	}
+	fullMessage := strings.Join(eventMessage, "\n")
+	return []byte(fullMessage)
 }
 
-// Функция для чтения файла с опредилением кодировки в Windows
-func (app *App) loadWinFileLog(filePath string) (output []byte, stringErrors string) {
-	app.lastContainerizationSystem = ""
-	if filePath == "" {
-		return nil, "file not selected"
-	}
-	app.debugStartTime = time.Now()
-	// Открываем файл
-	file, err := os.Open(filePath)
-	if err != nil {
-		return nil, fmt.Sprintf("open file: %v", err)
-	}
-	defer file.Close()
-	// Получаем информацию о файле
-	stat, err := file.Stat()
-	if err != nil {
-		return nil, fmt.Sprintf("get file stat: %v", err)
-	}
-	// Получаем размер файла
-	fileSize := stat.Size()
-	// Буфер для хранения последних строк
-	var buffer []byte
-	lineCount := 0
-	// Размер буфера чтения (читаем по 1КБ за раз)
-	readSize := int64(1024)
-	// Преобразуем строку с максимальным количеством строк в int
-	logViewCountInt, _ := strconv.Atoi(app.logViewCount)
-	// Читаем файл с конца
-	for fileSize > 0 && lineCount < logViewCountInt {
-		if fileSize < readSize {
-			readSize = fileSize
+// ---------------------------------------- Filesystem ----------------------------------------
+
+// Базовая структура os.Stat
+type fileInfo struct {
+	name    string
+	size    int64
+	modTime time.Time
+}
+
+// Дочерние методы os.Stat
+func (fi *fileInfo) Name() string       { return fi.name }
+func (fi *fileInfo) Size() int64        { return fi.size }
+func (fi *fileInfo) ModTime() time.Time { return fi.modTime }
+func (fi *fileInfo) Mode() os.FileMode  { return 0o644 } // default rights
+func (fi *fileInfo) IsDir() bool        { return false } // only file
+func (fi *fileInfo) Sys() any           { return nil }
+
+// Имитация метода os.Stat через исполнитель команд
+func (app *App) statFile(path string) (os.FileInfo, error) {
+	if app.sshMode {
+		// Ключи для перехода по символическим ссылкам для получения информации
+		// о целевых файлах (для проверки доступа) и форматирования вывода
+		output, err := app.runCommand("Loading the log files", "stat", "-L", "-c", "%n|%s|%Y", path)
+		if err != nil {
+			return nil, err
 		}
-		_, err := file.Seek(fileSize-readSize, 0)
+		// Парсим вывод stat (пример вывода: /var/log/syslog|8744995|1756116219)
+		line := strings.TrimSpace(string(output))
+		parts := strings.Split(line, "|")
+		if len(parts) != 3 {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidStat, line)
+		}
+		// Преобразуем размер и время в int
+		size, err := strconv.ParseInt(parts[1], 10, 64)
 		if err != nil {
-			return nil, fmt.Sprintf("detect the end of a file via seek: %v", err)
+			return nil, err
 		}
-		tempBuffer := make([]byte, readSize)
-		_, err = file.Read(tempBuffer)
+		modTimeUnix, err := strconv.ParseInt(parts[2], 10, 64)
 		if err != nil {
-			return nil, fmt.Sprintf("read file: %v", err)
+			return nil, err
 		}
-		buffer = append(tempBuffer, buffer...)
-		lineCount = strings.Count(string(buffer), "\n")
-		fileSize -= int64(readSize)
+		modTime := time.Unix(modTimeUnix, 0)
+		// Создаем кастомный FileInfo
+		return &fileInfo{
+			name:    parts[0],
+			size:    size,
+			modTime: modTime,
+		}, nil
+	} else {
+		// В локальном режиме возвращяем стандартный os.Stat
+		return os.Stat(path)
 	}
-	// Проверка на UTF-16 с BOM
-	utf16withBOM := func(data []byte) bool {
-		return len(data) >= 2 && ((data[0] == 0xFF && data[1] == 0xFE) || (data[0] == 0xFE && data[1] == 0xFF))
+}
+
+// Получение массива статистики по всем файлам
+func (app *App) statFiles(paths []string) (map[string]os.FileInfo, error) {
+	if len(paths) == 0 {
+		return make(map[string]os.FileInfo), nil
 	}
-	// Проверка на UTF-16 LE без BOM
-	utf16withoutBOM := func(data []byte) bool {
-		if len(data)%2 != 0 {
-			return false
+	args := make([]string, 0, 3+len(paths))
+	args = append(args, "-L", "-c", "%n|%s|%Y")
+	args = append(args, paths...)
+	output, _ := app.runCommand("Loading the log files", "stat", args...)
+	results := make(map[string]os.FileInfo)
+	lines := strings.Split(strings.TrimSpace(string(output)), "\n")
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
 		}
-		for i := 1; i < len(data); i += 2 {
-			if data[i] != 0x00 {
-				return false
-			}
+		parts := strings.Split(line, "|")
+		if len(parts) != 3 {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidStat, line)
 		}
-		return true
-	}
-	var decodedOutput []byte
-	switch {
-	case utf16withBOM(buffer):
-		// Декодируем UTF-16 с BOM
-		decodedOutput, err = winUnicode.UTF16(winUnicode.LittleEndian, winUnicode.ExpectBOM).NewDecoder().Bytes(buffer)
+		size, err := strconv.ParseInt(parts[1], 10, 64)
 		if err != nil {
-			return nil, fmt.Sprintf("decoding from UTF-16 with BOM: %v", err)
+			return nil, err
 		}
-	case utf16withoutBOM(buffer):
-		// Декодируем UTF-16 LE без BOM
-		decodedOutput, err = winUnicode.UTF16(winUnicode.LittleEndian, winUnicode.IgnoreBOM).NewDecoder().Bytes(buffer)
+		modTimeUnix, err := strconv.ParseInt(parts[2], 10, 64)
 		if err != nil {
-			return nil, fmt.Sprintf("decoding from UTF-16 LE without BOM: %v", err)
+			return nil, err
+		}
+		modTime := time.Unix(modTimeUnix, 0)
+		results[parts[0]] = &fileInfo{
+			name:    parts[0],
+			size:    size,
+			modTime: modTime,
+		}
+	}
+	return results, nil
+}
+
+// Функция для чтения последних строк из потока (замена конвейера с tail)
+func readLastLines(r io.Reader, count int) ([]string, error) {
+	if count <= 0 {
+		count = 1
+	}
+	// Кольцевой буфер для хранения последних строк
+	ring := make([]string, count)
+	total := 0
+	reader := bufio.NewReader(r)
+	for {
+		line, err := reader.ReadString('\n')
+		if line != "" {
+			ring[total%count] = strings.TrimSuffix(strings.TrimSuffix(line, "\n"), "\r")
+			total++
+		}
+		if err == io.EOF {
+			break
 		}
-	case utf8.Valid(buffer):
-		// Декодируем UTF-8
-		decodedOutput = buffer
-	default:
-		// Декодируем Windows-1251
-		decodedOutput, err = charmap.Windows1251.NewDecoder().Bytes(buffer)
 		if err != nil {
-			return nil, fmt.Sprintf("decoding from Windows-1251: %v", err)
+			return nil, err
 		}
 	}
-	return decodedOutput, "nil"
+	if total <= count {
+		return ring[:total], nil
+	}
+	start := total % count
+	return append(ring[start:], ring[:start]...), nil
 }
 
-// ---------------------------------------- Docker/Compose/Podman/Kubernetes ----------------------------------------
+// Размер блока для чтения файла с конца
+const reverseReadBlockSize = 64 * 1024
+
+// Функция для чтения последних строк файла блоками с конца (читается только нужная часть файла размером size)
+func readLastLinesAt(r io.ReaderAt, size int64, count int) ([]string, error) {
+	if count <= 0 {
+		count = 1
+	}
+	// Строки собираются в обратном порядке, части длинной строки из разных блоков объединяются при нахождении ее начала
+	var reversed []string
+	var parts [][]byte
+	addLine := func(first []byte) {
+		line := slices.Concat(append([][]byte{first}, parts...)...)
+		reversed = append(reversed, strings.TrimSuffix(string(line), "\r"))
+		parts = nil
+	}
+	offset := size
+	last := true
+	for offset > 0 && len(reversed) < count {
+		n := min(int64(reverseReadBlockSize), offset)
+		offset -= n
+		block := make([]byte, n)
+		if _, err := r.ReadAt(block, offset); err != nil && err != io.EOF {
+			return nil, err
+		}
+		end := len(block)
+		// Перенос в конце файла завершает последнюю строку и не образует пустую строку
+		if last && end > 0 && block[end-1] == '\n' {
+			end--
+		}
+		last = false
+		for i := end - 1; i >= 0 && len(reversed) < count; i-- {
+			if block[i] == '\n' {
+				addLine(block[i+1 : end])
+				end = i
+			}
+		}
+		if len(reversed) < count {
+			parts = append([][]byte{block[:end]}, parts...)
+		}
+	}
+	// Первая строка файла не имеет переноса перед собой
+	if offset == 0 && len(parts) > 0 && len(reversed) < count {
+		addLine(nil)
+	}
+	slices.Reverse(reversed)
+	return reversed, nil
+}
 
-func (app *App) loadDockerContainer(containerizationSystem string) {
-	if containerizationSystem == "kubernetes" {
-		containerizationSystem = "kubectl"
+// Функция для чтения последних строк текстового файла (локальный файл читается с конца в процессе, в режиме ssh используется tail)
+func (app *App) tailFile(ctx context.Context, timeout time.Duration, action string, path string) ([]string, error) {
+	if app.sshMode {
+		output, err := app.runCommandContext(ctx, timeout, action, "tail", "-n", app.logViewCount, path)
+		return strings.Split(strings.TrimSuffix(string(output), "\n"), "\n"), err
 	}
-	app.dockerContainers = nil
-	// Создаем контекст выполнения удаленных команд по ssh (timeout 5s)
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
-	// Получаем версию для проверки, что система контейнеризации установлена
-	var cmd *exec.Cmd
+	count, _ := strconv.Atoi(app.logViewCount)
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+	return readLastLinesAt(file, info.Size(), count)
+}
+
+// Расширения архивов ротированных журналов, которые распаковываются в процессе
+var archiveSuffixes = []string{".gz", ".xz", ".bz2", ".zst"}
+
+// Функция для проверки, что файл является сжатым архивом журнала
+func isArchiveLog(path string) bool {
+	return slices.ContainsFunc(archiveSuffixes, func(suffix string) bool {
+		return strings.HasSuffix(path, suffix)
+	})
+}
+
+// Поток распакованного архива с закрытием распаковщика и источника сжатых данных
+type archiveReader struct {
+	io.Reader
+	closers []func() error
+}
+
+func (r *archiveReader) Close() error {
+	var errs []error
+	for _, closer := range r.closers {
+		errs = append(errs, closer())
+	}
+	return errors.Join(errs...)
+}
+
+// Функция для открытия архива журнала с распаковкой в процессе (в режиме ssh сжатые данные читаются через cat и распаковываются локально)
+func (app *App) openArchive(ctx context.Context, path string) (io.ReadCloser, error) {
+	archive := &archiveReader{}
+	var source io.Reader
 	if app.sshMode {
-		if containerizationSystem == "compose" {
-			// Корректируем формат команды
-			if app.dockerCompose == "docker compose" {
-				cmd = exec.CommandContext(
-					ctx,
-					"ssh", append(app.sshOptions,
-						"docker", "compose", "version",
-					)...)
-			} else {
-				cmd = exec.CommandContext(
-					ctx,
-					"ssh", append(app.sshOptions,
-						app.dockerCompose, "version",
-					)...)
-			}
-		} else {
-			cmd = exec.CommandContext(
-				ctx,
-				"ssh", append(app.sshOptions,
-					containerizationSystem, "version",
-				)...)
+		proc, err := app.cmdExecutor().Start(ctx, Command{
+			Name:   "cat",
+			Args:   []string{path},
+			Action: "Reading the archive log",
+		})
+		if err != nil {
+			return nil, err
 		}
+		source = proc.Stdout
+		archive.closers = append(archive.closers, func() error {
+			// Дочитываем поток, чтобы команда завершилась до ожидания
+			io.Copy(io.Discard, proc.Stdout)
+			return proc.Wait()
+		})
 	} else {
-		if containerizationSystem == "compose" {
-			if app.dockerCompose == "docker compose" {
-				cmd = exec.Command(
-					"docker", "compose", "version",
-				)
-			} else {
-				cmd = exec.Command(
-					app.dockerCompose, "version",
-				)
-			}
-		} else {
-			cmd = exec.Command(
-				containerizationSystem, "version",
-			)
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, err
 		}
+		source = file
+		archive.closers = append(archive.closers, file.Close)
 	}
-	if app.logging {
-		slog.Info(cmd.String(), "action", "Check the binary")
+	reader, closer, err := decompressReader(path, bufio.NewReaderSize(source, 64*1024))
+	if err != nil {
+		archive.Close()
+		return nil, err
 	}
-	version, err := cmd.Output()
-	if err != nil && !app.testMode {
-		vError, _ := app.gui.View("docker")
-		vError.Clear()
-		app.dockerFrameColor = app.errorColor
-		vError.FrameColor = app.dockerFrameColor
-		vError.Highlight = false
-		switch containerizationSystem {
-		case "kubectl":
-			if strings.Contains(string(version), "Version:") {
-				// Проверяем вывод kubectl, может быть ошибка подключения к кластеру
-				cmd = exec.Command(containerizationSystem, "get", "nodes")
-				if app.logging {
-					slog.Info(cmd.String(), "action", "Check the connect to kubernetes cluster")
-				}
-				output, err := cmd.CombinedOutput()
-				if err != nil {
-					fmt.Fprintln(vError, "\033[31mError connection to the Kubernetes cluster\033[0m")
-					app.currentLogLines = []string{string(output)}
-					app.applyFilter(false)
-				}
-			} else {
-				fmt.Fprintln(vError, "\033[31m"+containerizationSystem+" not installed (environment not found)\033[0m")
-			}
-		case "compose":
-			fmt.Fprintln(vError, "\033[31m"+app.dockerCompose+" not installed (environment not found)\033[0m")
-		default:
-			fmt.Fprintln(vError, "\033[31m"+containerizationSystem+" not installed (environment not found)\033[0m")
-		}
-		return
+	archive.Reader = reader
+	if closer != nil {
+		archive.closers = slices.Insert(archive.closers, 0, closer)
 	}
-	if err != nil && app.testMode {
-		switch containerizationSystem {
-		case "kubectl":
-			log.Print("Error:", containerizationSystem+" not installed or no connection to the Kubernetes cluster")
-		case "compose":
-			log.Print("Error:", app.dockerCompose+" not installed (environment not found)")
-		default:
-			log.Print("Error:", containerizationSystem+" not installed (environment not found)")
+	return archive, nil
+}
+
+// Функция для создания распаковщика по расширению архива (gzip, bzip2, xz и zstd)
+func decompressReader(path string, r io.Reader) (io.Reader, func() error, error) {
+	switch {
+	case strings.HasSuffix(path, ".gz"):
+		reader, err := gzip.NewReader(r)
+		if err != nil {
+			return nil, nil, err
 		}
-	}
-	switch containerizationSystem {
-	case "kubectl":
-		// Получаем список подов из k8s
-		if app.sshMode {
-			cmd = exec.CommandContext(
-				ctx,
-				"ssh", append(app.sshOptions,
-					containerizationSystem, "get", "pods", "--context", app.kubernetesContext, app.kubernetesNamespace,
-					"-o", "'jsonpath={range .items[*]}{.metadata.uid} {.metadata.name} {.status.phase} {.metadata.namespace}{\"\\n\"}{end}'",
-				)...)
-		} else {
-			cmd = exec.CommandContext(
-				ctx,
-				containerizationSystem, "get", "pods", "--context", app.kubernetesContext, app.kubernetesNamespace,
-				"-o", "jsonpath={range .items[*]}{.metadata.uid} {.metadata.name} {.status.phase} {.metadata.namespace}{\"\\n\"}{end}",
-			)
+		return reader, reader.Close, nil
+	case strings.HasSuffix(path, ".bz2"):
+		return bzip2.NewReader(r), nil, nil
+	case strings.HasSuffix(path, ".xz"):
+		reader, err := xz.NewReader(r)
+		if err != nil {
+			return nil, nil, err
 		}
-	case "compose":
-		if app.sshMode {
-			// Корректируем положение флага context в команде compose (после docker и перед context)
-			if app.dockerCompose == "docker-compose" {
-				cmd = exec.CommandContext(
-					ctx,
-					"ssh", append(app.sshOptions,
-						app.dockerCompose,
-						"--context", app.dockerContext, "ls", "-a",
-					)...)
+		return reader, nil, nil
+	case strings.HasSuffix(path, ".zst"):
+		reader, err := zstd.NewReader(r)
+		if err != nil {
+			return nil, nil, err
+		}
+		return reader, func() error {
+			reader.Close()
+			return nil
+		}, nil
+	}
+	return nil, nil, fmt.Errorf("unsupported archive format: %s", filepath.Base(path))
+}
+
+func (app *App) loadFiles(logPath string) {
+	app.logfiles = nil // сбрасываем (очищаем) массив перед загрузкой новых журналов
+	var output []byte
+	collection, isCollection := app.logCollection(logPath)
+	switch {
+	case isCollection:
+		// Имена файлов в списке формируются относительно общего каталога шаблонов коллекции
+		logPath = collection.root()
+		output = app.findCollectionFiles(collection)
+		if !app.testMode {
+			if len(output) == 0 {
+				app.uiUpdate(func(app *App) {
+					vError, _ := app.gui.View("varLogs")
+					vError.Clear()
+					app.fileSystemFrameColor = app.errorColor
+					vError.FrameColor = app.fileSystemFrameColor
+					vError.Highlight = false
+					fmt.Fprintln(vError, "\033[31mFiles not found\033[0m")
+				})
+				return
 			} else {
-				cmd = exec.CommandContext(
-					ctx,
-					"ssh", append(app.sshOptions,
-						"docker",
-						"--context", app.dockerContext, "compose", "ls", "-a",
-					)...)
+				app.uiUpdate(func(app *App) {
+					vError, _ := app.gui.View("varLogs")
+					app.fileSystemFrameColor = app.frameColor
+					if vError.FrameColor != app.frameColor {
+						vError.FrameColor = app.selectedFrameColor
+					}
+					vError.Highlight = true
+				})
 			}
 		} else {
-			if app.dockerCompose == "docker-compose" {
-				cmd = exec.CommandContext(
-					ctx,
-					app.dockerCompose,
-					"--context", app.dockerContext, "ls", "-a",
-				)
-			} else {
-				cmd = exec.CommandContext(
-					ctx,
-					"docker",
-					"--context", app.dockerContext, "compose", "ls", "-a",
-				)
+			if len(output) == 0 {
+				log.Print("Error: files not found in collection ", collection.Name)
 			}
 		}
-
-	case "podman":
-		// #38 Отключаем использование контекста в Podman, если он не задан
-		if app.podmanContext == "" {
-			if app.sshMode {
-				cmd = exec.CommandContext(
-					ctx,
-					"ssh", append(app.sshOptions,
-						containerizationSystem,
-						"ps", "-a",
-						"--format", "'{{.ID}} {{.Names}} {{.State}}'",
-					)...)
+	case logPath == "descriptor":
+		// Ошибки при отсутствиее прав доступа (opendir: Permission denied) игнорируются
+		output, _ = app.runCommand("Loading the log files for process descriptor", "lsof", "-Fn")
+		// Разбиваем вывод на строки
+		files := strings.Split(strings.TrimSpace(string(output)), "\n")
+		// Если список файлов пустой, возвращаем ошибку Permission denied
+		if !app.testMode {
+			if len(files) == 0 || (len(files) == 1 && files[0] == "") {
+				app.uiUpdate(func(app *App) {
+					vError, _ := app.gui.View("varLogs")
+					vError.Clear()
+					// Меняем цвет окна на красный
+					app.fileSystemFrameColor = app.errorColor
+					vError.FrameColor = app.fileSystemFrameColor
+					// Отключаем курсор и выводим сообщение об ошибке
+					vError.Highlight = false
+					fmt.Fprintln(vError, "\033[31mPermission denied (files not found)\033[0m")
+				})
+				return
 			} else {
-				cmd = exec.CommandContext(
-					ctx,
-					containerizationSystem,
-					"ps", "-a",
-					"--format", "{{.ID}} {{.Names}} {{.State}}",
-				)
+				app.uiUpdate(func(app *App) {
+					vError, _ := app.gui.View("varLogs")
+					app.fileSystemFrameColor = app.frameColor
+					if vError.FrameColor != app.frameColor {
+						vError.FrameColor = app.selectedFrameColor
+					}
+					vError.Highlight = true
+				})
 			}
 		} else {
-			if app.sshMode {
-				cmd = exec.CommandContext(
-					ctx,
-					"ssh", append(app.sshOptions,
-						containerizationSystem,
-						"--context", app.podmanContext,
-						"ps", "-a",
-						"--format", "'{{.ID}} {{.Names}} {{.State}}'",
-					)...)
-			} else {
-				cmd = exec.CommandContext(
-					ctx,
-					containerizationSystem,
-					"--context", app.podmanContext,
-					"ps", "-a",
-					"--format", "{{.ID}} {{.Names}} {{.State}}",
-				)
+			if len(files) == 0 || (len(files) == 1 && files[0] == "") {
+				log.Print("Error: permission denied (files not found from descriptor)")
 			}
 		}
-	// Docker case
-	default:
-		if app.sshMode {
-			cmd = exec.CommandContext(
-				ctx,
-				"ssh", append(app.sshOptions,
-					containerizationSystem,
-					"--context", app.dockerContext,
-					"ps", "-a",
-					// Добавляем кавычки для передаваемых через пробел параметров в ssh
-					"--format", "'{{.ID}} {{.Names}} {{.State}}'",
-				)...)
-		} else {
-			cmd = exec.CommandContext(
-				ctx,
-				containerizationSystem,
-				"--context", app.dockerContext,
-				"ps", "-a",
-				"--format", "{{.ID}} {{.Names}} {{.State}}",
-			)
+		// Очищаем массив перед добавлением отфильтрованных файлов
+		output = []byte{}
+		// Фильтруем строки, которые заканчиваются на ".log" и удаляем префикс (имя файла)
+		for _, file := range files {
+			if strings.HasSuffix(file, ".log") {
+				file = strings.TrimPrefix(file, "n")
+				output = append(output, []byte(file+"\n")...)
+			}
 		}
-	}
-	// Ожидаем выполнение в течение 2-х секунд
-	cmd.WaitDelay = 2 * time.Second
-	if app.logging {
-		slog.Info(cmd.String(), "action", "Loading the container list")
-	}
-	output, err := cmd.Output()
-	if !app.testMode {
-		if err != nil {
-			vError, _ := app.gui.View("docker")
-			vError.Clear()
-			app.dockerFrameColor = app.errorColor
-			vError.FrameColor = app.dockerFrameColor
-			vError.Highlight = false
-			if containerizationSystem == "kubectl" {
-				fmt.Fprintln(vError, "\033[31mUnable to access the Kubernetes cluster\033[0m")
-			} else {
-				fmt.Fprintln(vError, "\033[31mAccess denied or "+containerizationSystem+" service stopped\033[0m")
+	case logPath == "varlog":
+		logPath = "/var/log/"
+		var args []string
+		// Загрузка системных журналов для macOS
+		if app.getOS == "darwin" {
+			args = []string{
+				logPath, "/Library/Logs",
+				"-type", "f",
+				"-name", "*.asl", "-o",
+				"-name", "*.log", "-o",
+				"-name", "*log*", "-o",
+				"-name", "*.[0-9]*", "-o",
+				"-name", "*.[0-9].*", "-o",
+				"-name", "*.pcap", "-o",
+				"-name", "*.pcap.gz", "-o",
+				"-name", "*.pcapng", "-o",
+				"-name", "*.pcapng.gz",
 			}
-			return
 		} else {
-			vError, _ := app.gui.View("docker")
-			vError.Highlight = true
-			app.dockerFrameColor = app.frameColor
-			if vError.FrameColor != app.frameColor {
-				vError.FrameColor = app.selectedFrameColor
+			// Загрузка системных журналов для Linux: все файлы, которые содержат log в расширение или названии (архивы включительно), а также расширение с цифрой (архивные) и pcap/pcapng
+			args = []string{
+				logPath,
+				"-type", "f",
+				"-name", "*.log", "-o",
+				"-name", "*log*", "-o",
+				"-name", "*.[0-9]*", "-o",
+				"-name", "*.[0-9].*", "-o",
+				"-name", "*.pcap", "-o",
+				"-name", "*.pcap", "-o",
+				"-name", "*.pcap.gz", "-o",
+				"-name", "*.pcapng", "-o",
+				"-name", "*.pcapng.gz",
 			}
 		}
-	}
-	if err != nil && app.testMode {
-		if containerizationSystem == "kubectl" {
-			log.Print("Error: unable to access the Kubernetes cluster")
+		output, _ = app.runCommand("Loading the log files from var logs", "find", args...)
+		// Преобразуем вывод команды в строку и делим на массив строк
+		files := strings.Split(strings.TrimSpace(string(output)), "\n")
+		// Если список файлов пустой, возвращаем ошибку Permission denied
+		if !app.testMode {
+			if len(files) == 0 || (len(files) == 1 && files[0] == "") {
+				app.uiUpdate(func(app *App) {
+					vError, _ := app.gui.View("varLogs")
+					vError.Clear()
+					// Меняем цвет окна на красный
+					app.fileSystemFrameColor = app.errorColor
+					vError.FrameColor = app.fileSystemFrameColor
+					// Отключаем курсор и выводим сообщение об ошибке
+					vError.Highlight = false
+					fmt.Fprintln(vError, "\033[31mPermission denied (files not found)\033[0m")
+				})
+				return
+			} else {
+				app.uiUpdate(func(app *App) {
+					vError, _ := app.gui.View("varLogs")
+					app.fileSystemFrameColor = app.frameColor
+					if vError.FrameColor != app.frameColor {
+						vError.FrameColor = app.selectedFrameColor
+					}
+					vError.Highlight = true
+				})
+			}
 		} else {
-			log.Print("Error: access denied or " + containerizationSystem + " service stopped")
+			if len(files) == 0 || (len(files) == 1 && files[0] == "") {
+				log.Print("Error: files not found in /var/log")
+			}
 		}
-	}
-	var containers []string
-	var stringOutput string
-	// Парсим вывод compose
-	if containerizationSystem == "compose" {
-		stacks := strings.Split(strings.TrimSpace(string(output)), "\n")
-		// Удаляем первую строку (элемент массива)
-		stacks = stacks[1:]
-		if len(stacks) != 0 {
-			// Удаляем путь к конфигурационному файлу compose для каждой строки (элемента)
-			for i, e := range stacks {
-				line := strings.Split(e, "/")
-				stacks[i] = line[0]
-			}
+		// Добавляем пути по умолчанию для /var/log
+		logPaths := []string{
+			// Ядро
+			"/var/log/dmesg\n",
+			// Информация о входах и выходах пользователей, перезагрузках и остановках системы
+			"/var/log/wtmp\n",
+			// Информация о неудачных попытках входа в систему (например, неправильные пароли)
+			"/var/log/btmp\n",
+			// Информация о текущих пользователях, их сеансах и входах в систему
+			"/var/run/utmp\n",
+			"/run/utmp\n",
+			// macOS/BSD/RHEL
+			"/var/log/secure\n",
+			"/var/log/messages\n",
+			"/var/log/daemon\n",
+			"/var/log/lpd-errs\n",
+			"/var/log/security.out\n",
+			"/var/log/daily.out\n",
+			// Службы
+			"/var/log/cron\n",
+			"/var/log/ftpd\n",
+			"/var/log/ntpd\n",
+			"/var/log/named\n",
+			"/var/log/dhcpd\n",
 		}
-		containers = stacks
-		stringOutput = strings.Join(containers, "\n")
-	} else {
-		containers = strings.Split(strings.TrimSpace(string(output)), "\n")
-		stringOutput = string(output)
-	}
-	// Проверяем, что список контейнеров не пустой
-	if !app.testMode {
-		if len(containers) == 0 || (len(containers) == 1 && containers[0] == "") {
-			vError, _ := app.gui.View("docker")
-			vError.Clear()
-			vError.Highlight = false
-			fmt.Fprintln(vError, "\033[32mNo running containers\033[0m")
-			return
-		} else {
-			vError, _ := app.gui.View("docker")
-			app.fileSystemFrameColor = app.frameColor
-			if vError.FrameColor != app.frameColor {
-				vError.FrameColor = app.selectedFrameColor
-			}
-			vError.Highlight = true
+		for _, path := range logPaths {
+			output = append([]byte(path), output...)
 		}
-	}
-	// Заполняем структуру dockerContainers (название и статус)
-	serviceMap := make(map[string]bool)
-	scanner := bufio.NewScanner(strings.NewReader(stringOutput))
-	for scanner.Scan() {
-		idName := scanner.Text()
-		parts := strings.Fields(idName)
-		if idName != "" && !serviceMap[idName] {
-			serviceMap[idName] = true
-			var containerName string
-			var containerStatus string
-			if containerizationSystem == "compose" {
-				// Извлекаем имя стеке из первого параметра (в compose отсутствует id)
-				containerName = parts[0]
-				// Собираем все статусы в одну строку
-				containerStatus = strings.Join(parts[1:], " ")
+	case logPath == "customPath":
+		logPath = app.customPath
+		output, _ = app.runCommand(
+			"Loading the log files from custom path",
+			"find", logPath,
+			"-type", "f",
+			"-name", "*.log", "-o",
+			"-name", "*.log.*", "-o",
+			"-name", "*.asl", "-o",
+			"-name", "*.pcap", "-o",
+			"-name", "*.pcap.gz", "-o",
+			"-name", "*.pcapng", "-o",
+			"-name", "*.pcapng.gz",
+		)
+		files := strings.Split(strings.TrimSpace(string(output)), "\n")
+		if !app.testMode {
+			if len(files) == 0 || (len(files) == 1 && files[0] == "") {
+				app.uiUpdate(func(app *App) {
+					vError, _ := app.gui.View("varLogs")
+					vError.Clear()
+					// Меняем цвет окна на красный
+					app.fileSystemFrameColor = app.errorColor
+					vError.FrameColor = app.fileSystemFrameColor
+					// Отключаем курсор и выводим сообщение об ошибке
+					vError.Highlight = false
+					fmt.Fprintln(vError, "\033[31mFiles not found\033[0m")
+				})
+				return
 			} else {
-				containerName = parts[1]
-				containerStatus = parts[2]
-			}
-			composeStatus := containerStatus
-			// Проверяем статус для покраски
-			switch {
-			case strings.HasPrefix(strings.ToLower(containerStatus), "running") ||
-				strings.HasPrefix(strings.ToLower(containerStatus), "succe"):
-				containerStatus = "\033[32m"
-			case strings.HasPrefix(strings.ToLower(containerStatus), "pending") ||
-				strings.HasPrefix(strings.ToLower(containerStatus), "pause") ||
-				strings.HasPrefix(strings.ToLower(containerStatus), "restart") ||
-				strings.Contains(strings.ToLower(containerStatus), "exited") && strings.Contains(strings.ToLower(containerStatus), "running"):
-				containerStatus = "\033[33m"
-			default:
-				containerStatus = "\033[31m"
-			}
-			rawContainerName := containerName
-			if containerizationSystem == "compose" {
-				// Извлекаем количество запущенных контейнеров из статуса
-				var runContainersInt int
-				runContainersArr := strings.Split(composeStatus, "running(")
-				if len(runContainersArr) > 1 {
-					runContainersArr = strings.Split(runContainersArr[1], ")")
-					runContainersInt, err = strconv.Atoi(runContainersArr[0])
-					if err != nil {
-						runContainersInt = 0
-					}
-				} else {
-					runContainersArr = []string{"0"}
-					runContainersInt = 0
-				}
-				// Извлекаем количество остановленных и перезапускающихся контейнеров из статуса
-				var exitContainersInt int
-				var restartContainersInt int
-				exitContainersArr := strings.Split(composeStatus, "exited(")
-				if len(exitContainersArr) > 1 {
-					exitContainersArr = strings.Split(exitContainersArr[1], ")")
-					exitContainersInt, err = strconv.Atoi(exitContainersArr[0])
-					if err != nil {
-						exitContainersInt = 0
-					}
-				} else {
-					exitContainersInt = 0
-				}
-				restartContainersArr := strings.Split(composeStatus, "restarting(")
-				if len(restartContainersArr) > 1 {
-					restartContainersArr = strings.Split(restartContainersArr[1], ")")
-					restartContainersInt, err = strconv.Atoi(restartContainersArr[0])
-					if err != nil {
-						restartContainersInt = 0
+				app.uiUpdate(func(app *App) {
+					vError, _ := app.gui.View("varLogs")
+					app.fileSystemFrameColor = app.frameColor
+					if vError.FrameColor != app.frameColor {
+						vError.FrameColor = app.selectedFrameColor
 					}
-				} else {
-					restartContainersInt = 0
-				}
-				allContainers := strconv.Itoa(runContainersInt + exitContainersInt + restartContainersInt)
-				containerName = "[" + runContainersArr[0] + " of " + allContainers + "] " + containerStatus + containerName + "\033[0m"
-			} else {
-				containerName = containerStatus + containerName + "\033[0m"
+					vError.Highlight = true
+				})
 			}
-			// Фиксируем название namespace для k8s
-			var namespace string
-			if containerizationSystem != "kubectl" || parts[3] == "" {
-				namespace = ""
-			} else {
-				namespace = parts[3]
+		} else {
+			if len(files) == 0 || (len(files) == 1 && files[0] == "") {
+				log.Print("Error: files not found in ", logPath)
 			}
-			app.dockerContainers = append(app.dockerContainers, DockerContainers{
-				name:      containerName,
-				rawName:   rawContainerName,
-				id:        parts[0],
-				namespace: namespace,
-			})
 		}
-	}
-	sort.Slice(app.dockerContainers, func(i, j int) bool {
-		return app.dockerContainers[i].name < app.dockerContainers[j].name
-	})
-	if !app.testMode {
-		app.dockerContainersNotFilter = app.dockerContainers
-		app.applyFilterList()
-	}
-}
-
-func (app *App) updateDockerContainerList() {
-	v, err := app.gui.View("docker")
-	if err != nil {
-		return
-	}
-	v.Clear()
-	visibleEnd := min(app.startDockerContainers+app.maxVisibleDockerContainers, len(app.dockerContainers))
-	for i := app.startDockerContainers; i < visibleEnd; i++ {
-		fmt.Fprintln(v, app.dockerContainers[i].name)
-	}
-}
-
-func (app *App) nextDockerContainer(v *gocui.View, step int) error {
-	_, viewHeight := v.Size()
-	app.maxVisibleDockerContainers = viewHeight
-	if len(app.dockerContainers) == 0 {
-		return nil
-	}
-	if app.selectedDockerContainer < len(app.dockerContainers)-1 {
-		app.selectedDockerContainer += step
-		if app.selectedDockerContainer >= len(app.dockerContainers) {
-			app.selectedDockerContainer = len(app.dockerContainers) - 1
+	default:
+		// Определяем домашний каталог в Linux
+		logPath = "/home/"
+		// Домашний каталог в macOS
+		if app.getOS == "darwin" {
+			logPath = "/Users/"
 		}
-		if app.selectedDockerContainer >= app.startDockerContainers+app.maxVisibleDockerContainers {
-			app.startDockerContainers += step
-			if app.startDockerContainers > len(app.dockerContainers)-app.maxVisibleDockerContainers {
-				app.startDockerContainers = len(app.dockerContainers) - app.maxVisibleDockerContainers
+		// Ищем файлы с помощью системной утилиты find
+		args := []string{
+			logPath,
+			"-type", "f",
+			"-name", "*.log", "-o",
+			"-name", "*.asl", "-o",
+			"-name", "*.pcap", "-o",
+			"-name", "*.pcap.gz", "-o",
+			"-name", "*.pcapng", "-o",
+			"-name", "*.pcapng.gz",
+		}
+		output, _ = app.runCommand("Loading the log files from users home", "find", args...)
+		files := strings.Split(strings.TrimSpace(string(output)), "\n")
+		if !app.testMode {
+			if len(files) == 0 || (len(files) == 1 && files[0] == "") {
+				app.uiUpdate(func(app *App) {
+					vError, _ := app.gui.View("varLogs")
+					vError.Clear()
+					vError.Highlight = false
+					fmt.Fprintln(vError, "\033[32mFiles not found\033[0m")
+				})
+				return
+			} else {
+				app.uiUpdate(func(app *App) {
+					vError, _ := app.gui.View("varLogs")
+					app.fileSystemFrameColor = app.frameColor
+					if vError.FrameColor != app.frameColor {
+						vError.FrameColor = app.selectedFrameColor
+					}
+					vError.Highlight = true
+				})
+			}
+		} else {
+			if len(files) == 0 || (len(files) == 1 && files[0] == "") {
+				log.Print("Error: files not found in home directories")
 			}
-			app.updateDockerContainerList()
 		}
-		if app.selectedDockerContainer < app.startDockerContainers+app.maxVisibleDockerContainers {
-			return app.selectDockerByIndex(app.selectedDockerContainer - app.startDockerContainers)
+		// Получаем содержимое файлов из домашнего каталога пользователя root
+		args = []string{
+			"/root/",
+			"-type", "f",
+			"-name", "*.log", "-o",
+			"-name", "*.pcap", "-o",
+			"-name", "*.pcap.gz", "-o",
+			"-name", "*.pcapng", "-o",
+			"-name", "*.pcapng.gz",
 		}
-	}
-	return nil
-}
-
-func (app *App) prevDockerContainer(v *gocui.View, step int) error {
-	_, viewHeight := v.Size()
-	app.maxVisibleDockerContainers = viewHeight
-	if len(app.dockerContainers) == 0 {
-		return nil
-	}
-	if app.selectedDockerContainer > 0 {
-		app.selectedDockerContainer -= step
-		if app.selectedDockerContainer < 0 {
-			app.selectedDockerContainer = 0
+		outputRootDir, err := app.runCommand("Loading the log files from users home", "find", args...)
+		// Добавляем содержимое директории /root/ в общий массив, если есть доступ
+		if err == nil {
+			output = append(output, outputRootDir...)
 		}
-		if app.selectedDockerContainer < app.startDockerContainers {
-			app.startDockerContainers -= step
-			if app.startDockerContainers < 0 {
-				app.startDockerContainers = 0
+		app.uiUpdate(func(app *App) {
+			if app.fileSystemFrameColor == app.errorColor {
+				vError, _ := app.gui.View("varLogs")
+				app.fileSystemFrameColor = app.frameColor
+				if vError.FrameColor != app.frameColor {
+					vError.FrameColor = app.selectedFrameColor
+				}
+				vError.Highlight = true
 			}
-			app.updateDockerContainerList()
+		})
+	}
+	// Формируем массив путей
+	logFullPaths := strings.Split(strings.TrimSpace(string(output)), "\n")
+	// Получаем статистику по всем файлам одним вызовом в режиме ssh
+	var statFiles map[string]os.FileInfo
+	if app.sshMode {
+		statFiles, _ = app.statFiles(logFullPaths)
+	}
+	// Карта уникальных путей
+	serviceMap := make(map[string]bool)
+	// Основной цикл
+	for _, logFullPath := range logFullPaths {
+		// Удаляем префикс пути и расширение файла в конце
+		logName := logFullPath
+		if logPath != "descriptor" {
+			logName = strings.TrimPrefix(logFullPath, logPath)
 		}
-		if app.selectedDockerContainer >= app.startDockerContainers {
-			return app.selectDockerByIndex(app.selectedDockerContainer - app.startDockerContainers)
+		logName = strings.TrimSuffix(logName, ".log")
+		logName = strings.TrimSuffix(logName, ".asl")
+		logName = strings.TrimSuffix(logName, ".gz")
+		logName = strings.TrimSuffix(logName, ".xz")
+		logName = strings.TrimSuffix(logName, ".bz2")
+		logName = strings.TrimSuffix(logName, ".zst")
+		logName = strings.ReplaceAll(logName, "/", " ")
+		logName = strings.ReplaceAll(logName, ".log.", ".")
+		logName = strings.TrimPrefix(logName, " ")
+		if logPath == "/home/" || logPath == "/Users/" {
+			// Разбиваем строку на слова
+			words := strings.Fields(logName)
+			// Берем первое и последнее слово
+			firstWord := words[0]
+			lastWord := words[len(words)-1]
+			logName = "\x1b[0;33m" + firstWord + "\033[0m" + ": " + lastWord
+		}
+		// Получаем информацию о файле
+		var fileInfo os.FileInfo
+		var exists bool
+		var err error
+		if app.sshMode {
+			// Извлекаем статистику из массива
+			fileInfo, exists = statFiles[logFullPath]
+			// Пропускаем файл, если он не найден в результатах
+			if !exists {
+				continue
+			}
+		} else {
+			// Запрашиваем статистику для каждого файла в локальном режиме
+			fileInfo, err = os.Stat(logFullPath)
+			if err != nil {
+				// Пропускаем файл, если к нему нет доступа (актуально для статических файлов из переменной logPath)
+				continue
+			}
+		}
+		// Проверяем, что файл не пустой
+		if fileInfo.Size() == 0 {
+			// Пропускаем пустой файл
+			continue
+		}
+		// Проверяем, что полного пути до файла еще нет в списке
+		if logName != "" && !serviceMap[logFullPath] {
+			// Добавляем путь в массив для проверки уникальных путей
+			serviceMap[logFullPath] = true
+			// Получаем имя процесса для файла дескриптора
+			if logPath == "descriptor" {
+				outputLsof, _ := app.runCommand("Loading the log files for process descriptor", "lsof", "-Fc", logFullPath)
+				processLines := strings.Split(strings.TrimSpace(string(outputLsof)), "\n")
+				// Ищем строку, которая содержит имя процесса (только первый процесс)
+				for _, line := range processLines {
+					if strings.HasPrefix(line, "c") {
+						// Удаляем префикс
+						processName := line[1:]
+						logName = "\x1b[0;33m" + processName + "\033[0m" + ": " + logName
+						break
+					}
+				}
+			}
+			// Выделение цветом подов и контейнеров k3s из файловой системы
+			if strings.HasPrefix(logName, "pods") {
+				logName = strings.Replace(logName, "pods", "\033[33mpod\033[0m", 1)
+			}
+			if strings.HasPrefix(logName, "containers") {
+				logName = strings.Replace(logName, "containers", "\033[32mcontainer\033[0m", 1)
+			}
+			// Добавляем в список
+			app.logfiles = append(app.logfiles, newLogfile(logName, logFullPath, fileInfo))
 		}
 	}
-	return nil
+	// Сортируем по дате изменения (по умолчанию), имени или размеру
+	sortLogFiles(app.logfiles, app.fileSortMode)
+	if app.familyView {
+		app.logfiles = groupLogFamilies(app.logfiles)
+	}
+	logfiles := app.logfiles
+	refresh := app.fileListRefresh
+	app.uiUpdate(func(app *App) {
+		// При обновлении на месте сохраняются фильтр и выбранный файл
+		if refresh {
+			app.refreshFileList(logfiles)
+		} else {
+			app.logfiles = logfiles
+			app.logfilesNotFilter = app.logfiles
+			app.applyFilterList()
+		}
+		v, err := app.gui.View("varLogs")
+		if err == nil {
+			curTime := time.Now().Format("02.01.2006 15:04:05")
+			v.Subtitle = "[ " + curTime + " ]"
+		}
+		app.watchFileList(logPath, logfiles)
+	})
 }
 
-func (app *App) selectDockerByIndex(index int) error {
-	v, err := app.gui.View("docker")
-	if err != nil {
-		return err
+// Задержка обновления списка файлов после последнего изменения в каталогах (несколько изменений объединяются в одно обновление)
+const fileListRefreshDelay = time.Second
+
+// Интервал опроса списка файлов в режиме ssh
+const fileListPollInterval = 10 * time.Second
+
+// Максимальное количество наблюдаемых каталогов (при большем количестве список обновляется опросом)
+const fileListWatchLimit = 4096
+
+// Функция для запуска наблюдения за каталогами списка файлов (inotify локально и периодический опрос в режиме ssh)
+func (app *App) watchFileList(logPath string, logfiles []Logfile) {
+	if app.fileListWatch != nil {
+		app.fileListWatch()
+		app.fileListWatch = nil
 	}
-	// Обновляем счетчик в заголовке
-	re := regexp.MustCompile(`\s\(.+\) >`)
-	updateTitle := " (0) >"
-	if len(app.dockerContainers) != 0 {
-		updateTitle = " (" + strconv.Itoa(app.selectedDockerContainer+1) + "/" + strconv.Itoa(len(app.dockerContainers)) + ") >"
+	// Список файлов дескрипторов процессов не связан с каталогами
+	if app.testMode || logPath == "descriptor" {
+		return
 	}
-	v.Title = re.ReplaceAllString(v.Title, updateTitle)
-	if err := v.SetCursor(0, index); err != nil {
-		return nil
+	ctx, cancel := context.WithCancel(context.Background())
+	app.fileListWatch = cancel
+	if app.sshMode {
+		go app.pollFileList(ctx)
+		return
 	}
-	return nil
+	// Наблюдаем за каталогом поиска с подкаталогами и каталогами всех файлов списка (файлы вне каталога поиска при переходе по ссылкам)
+	var dirs []string
+	for _, logfile := range logfiles {
+		for _, path := range append([]string{logfile.path}, logfile.family...) {
+			dirs = append(dirs, filepath.Dir(path))
+		}
+	}
+	go app.runFileListWatch(ctx, filepath.Clean(logPath), dirs)
 }
 
-func (app *App) selectDocker(g *gocui.Gui, v *gocui.View) error {
-	if v == nil || len(app.dockerContainers) == 0 {
-		return nil
-	}
-	_, cy := v.Cursor()
-	line, err := v.Line(cy)
-	if err != nil {
-		return err
-	}
-	if app.fastMode {
-		go func() {
-			app.loadDockerLogs(strings.TrimSpace(line), true)
-		}()
-	} else {
-		app.loadDockerLogs(strings.TrimSpace(line), true)
-	}
-	app.lastWindow = "docker"
-	app.lastSelected = strings.TrimSpace(line)
-	return nil
+// Тип для наблюдения за деревьями каталогов через inotify
+type dirWatcher struct {
+	*fsnotify.Watcher
+	watched map[string]bool
 }
 
-func (app *App) loadDockerLogs(containerName string, newUpdate bool) {
-	// Прерываем выполнение функции, если имя контейнера пустое (при выборе пустого поля с помощью мыши)
-	if containerName == "" {
-		return
+// Функция для добавления каталога в наблюдение (возвращает false при превышении лимита каталогов)
+func (w *dirWatcher) addDir(dir string) bool {
+	if w.watched[dir] {
+		return true
 	}
-	app.debugStartTime = time.Now()
-	containerizationSystem := app.selectContainerizationSystem
-	// Сохраняем систему контейнеризации для автообновления при смене окна
-	if newUpdate {
-		app.lastContainerizationSystem = app.selectContainerizationSystem
-	} else {
-		containerizationSystem = app.lastContainerizationSystem
+	if len(w.watched) >= fileListWatchLimit {
+		return false
 	}
-	// Обновляем статус с названием источника журнала (имя контейнера)
-	if !app.testMode {
-		v, err := app.gui.View("logs")
-		if err == nil {
-			v.Subtitle = "[ " + containerizationSystem + "/" + containerName + " ]"
-		}
+	if w.Add(dir) == nil {
+		w.watched[dir] = true
 	}
-	if !app.testMode {
-		v, err := app.gui.View("logs")
-		containerNameWithoutStatus := containerName
-		containerNameSplit := strings.Split(containerNameWithoutStatus, "] ")
-		if len(containerNameSplit) == 2 && len(containerNameSplit[1]) > 0 {
-			containerNameWithoutStatus = containerNameSplit[1]
+	return true
+}
+
+// Функция для добавления каталога с подкаталогами в наблюдение (возвращает false при превышении лимита каталогов)
+func (w *dirWatcher) addTree(root string) bool {
+	err := filepath.WalkDir(root, func(path string, d os.DirEntry, err error) error {
+		// Каталоги без прав доступа пропускаются
+		if err != nil || !d.IsDir() {
+			return nil
 		}
-		if err == nil {
-			v.Subtitle = "[ " + containerizationSystem + "/" + containerNameWithoutStatus + " ]"
+		if !w.addDir(path) {
+			return errWatchLimit
 		}
+		return nil
+	})
+	return err == nil
+}
+
+// Ошибка превышения лимита наблюдаемых каталогов
+var errWatchLimit = errors.New("too many directories to watch")
+
+// Функция для обновления списка файлов после создания, удаления или переименования файлов в наблюдаемых каталогах
+func (app *App) runFileListWatch(ctx context.Context, root string, dirs []string) {
+	fsWatcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		app.pollFileList(ctx)
+		return
 	}
-	if containerizationSystem == "kubernetes" {
-		containerizationSystem = "kubectl"
-	}
-	// Извлекаем id контейнера и namespace для подов k8s
-	var containerId string
-	var namespace string
-	for _, dockerContainer := range app.dockerContainers {
-		dockerContainerName := ansiEscape.ReplaceAllString(dockerContainer.name, "")
-		if dockerContainerName == containerName {
-			containerId = dockerContainer.id
-			namespace = dockerContainer.namespace
-		}
+	watcher := &dirWatcher{Watcher: fsWatcher, watched: make(map[string]bool)}
+	defer watcher.Close()
+	added := watcher.addTree(root)
+	for _, dir := range dirs {
+		added = added && watcher.addDir(dir)
 	}
-	// Сохраняем id контейнера для автообновления при смене окна
-	if newUpdate {
-		app.lastContainerId = containerId
-	} else {
-		containerId = app.lastContainerId
+	// Слишком большое дерево каталогов обновляется опросом
+	if !added {
+		watcher.Close()
+		app.pollFileList(ctx)
+		return
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
-	// Читаем журналы Docker из файловой системы в формате JSON (если не отключено флагом и docker context default)
-	var readFileContainer bool
-	if containerizationSystem == "docker" && !app.dockerStreamLogs && app.dockerContext == "default" {
-		// Получаем путь к журналу контейнера в файловой системе по id с помощью метода docker cli
-		var cmd *exec.Cmd
-		if app.sshMode {
-			cmd = exec.CommandContext(
-				ctx,
-				"ssh", append(app.sshOptions,
-					"docker", "inspect", "--format", "{{.LogPath}}", containerId,
-				)...)
-		} else {
-			cmd = exec.Command("docker", "inspect", "--format", "{{.LogPath}}", containerId)
-		}
-		if app.logging {
-			slog.Info(cmd.String(), "action", "Reading "+containerName+" container logs from file system")
-		}
-		logFilePathBytes, err := cmd.Output()
-		if err != nil && !app.testMode {
-			v, _ := app.gui.View("logs")
-			v.Clear()
-			fmt.Fprintln(v, "\033[31mError get log path via docker inspect:", err, "\033[0m")
+	var refresh <-chan time.Time
+	for {
+		select {
+		case <-ctx.Done():
 			return
-		}
-		if err != nil && app.testMode {
-			log.Print("Error: get log path via docker inspect. ", err)
-		}
-		logFilePath := strings.TrimSpace(string(logFilePathBytes))
-		// Читаем файл с конца с помощью tail
-		if app.sshMode {
-			cmd = exec.CommandContext(
-				ctx,
-				"ssh", append(app.sshOptions,
-					"tail", "-n", app.logViewCount, logFilePath,
-				)...)
-		} else {
-			cmd = exec.Command("tail", "-n", app.logViewCount, logFilePath)
-		}
-		if app.logging {
-			slog.Info(cmd.String(), "action", "Reading "+containerName+" container logs from file system")
-		}
-		output, err := cmd.Output()
-		// Если ошибка чтения, значит нет доступа и переходим к чтению из потока
-		if err != nil && app.dockerStreamLogsStatus == "json-file" {
-			readFileContainer = false
-			app.dockerStreamLogsStatus = app.dockerStreamMode
-			app.dockerStreamLogs = true
-			if !app.testMode {
-				go func() {
-					text := "Access denied to json logs (use root)"
-					app.showInterfaceInfo(g, true, text)
-					time.Sleep(3 * time.Second)
-					app.closeInfo(g)
-				}()
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return
 			}
-		} else {
-			readFileContainer = true
-			app.dockerStreamLogsStatus = "json-file"
-		}
-		if readFileContainer {
-			// Проверяем, что есть изменения в файле при повторном считывание
-			if newUpdate {
-				// Фиксируем новую дату изменения и размер для выбранного файла
-				fileInfo, err := app.statFile(logFilePath)
-				if err != nil {
-					return
-				}
-				fileModTime := fileInfo.ModTime()
-				fileSize := fileInfo.Size()
-				app.lastDateUpdateFile = fileModTime
-				app.lastSizeFile = fileSize
-				app.updateFile = true
-			} else {
-				// Проверяем дату изменения
-				fileInfo, err := app.statFile(logFilePath)
-				if err != nil {
+			// Новые каталоги добавляются в наблюдение вместе с созданными в них файлами и подкаталогами
+			if event.Has(fsnotify.Create) {
+				if info, err := os.Stat(event.Name); err == nil && info.IsDir() && !watcher.addTree(event.Name) {
+					watcher.Close()
+					app.pollFileList(ctx)
 					return
 				}
-				fileModTime := fileInfo.ModTime()
-				fileSize := fileInfo.Size()
-				// Обновлять файл, только если есть изменения (проверяем дату модификации и размер)
-				if fileModTime != app.lastDateUpdateFile || fileSize != app.lastSizeFile {
-					app.lastDateUpdateFile = fileModTime
-					app.lastSizeFile = fileSize
-					app.updateFile = true
-				} else {
-					app.updateFile = false
+			}
+			// Удаленные каталоги убираются из наблюдения автоматически
+			if event.Has(fsnotify.Remove) || event.Has(fsnotify.Rename) {
+				delete(watcher.watched, event.Name)
+			}
+			// Запись в файл не меняет состав списка
+			if event.Has(fsnotify.Create) || event.Has(fsnotify.Remove) || event.Has(fsnotify.Rename) {
+				if refresh == nil {
+					refresh = time.After(fileListRefreshDelay)
 				}
 			}
-			// Читаем файл, толькое если были изменения
-			if app.updateFile {
-				// Разбиваем строки на массив
-				lines := strings.Split(strings.TrimSpace(string(output)), "\n")
-				var formattedLines []string
-				// Обрабатываем вывод в формате JSON построчно
-				for _, line := range lines {
-					// JSON-структура для парсинга
-					var jsonData map[string]any
-					err := json.Unmarshal([]byte(line), &jsonData)
-					if err != nil {
-						continue
-					}
-					// Извлекаем JSON данные
-					stream, _ := jsonData["stream"].(string)
-					timeStr, _ := jsonData["time"].(string)
-					logMessage, _ := jsonData["log"].(string)
-					// Проверяем режим вывода потоков и пропускаем лишние строки
-					// Если текущий режим соответствует стандартному выводу и текущая строка содержит поток ошибки (или наоборот), пропускаем интерацию
-					if app.dockerStreamMode == "stdout" && stream == "stderr" {
-						continue
-					}
-					if app.dockerStreamMode == "stderr" && stream == "stdout" {
-						continue
-					}
-					// Удаляем встроенный экранированный символ переноса строки
-					logMessage = strings.TrimSuffix(logMessage, "\n")
-					// Парсим строку времени в объект time.Time
-					parsedTime, err := time.Parse(time.RFC3339Nano, timeStr)
-					if err == nil {
-						// Форматируем дату в формате: YYYY-MM-DDTHH:MM:SS.MS(x9)Z
-						timeStr = parsedTime.Format("2006-01-02T15:04:05.000000000Z")
-					}
-					var formattedLine string
-					// Заполняем строку в формате
-					switch {
-					case app.timestampDocker && app.streamTypeDocker:
-						// stream time log
-						formattedLine = fmt.Sprintf("%s %s %s", stream, timeStr, logMessage)
-					case app.timestampDocker && !app.streamTypeDocker:
-						// time log
-						formattedLine = fmt.Sprintf("%s %s", timeStr, logMessage)
-					case !app.timestampDocker && app.streamTypeDocker:
-						// stream log
-						formattedLine = fmt.Sprintf("%s %s", stream, logMessage)
-					case !app.timestampDocker && !app.streamTypeDocker:
-						// log only
-						formattedLine = logMessage
-					}
-					formattedLines = append(formattedLines, formattedLine)
-					// Если это последняя строка в выводе, добавляем перенос строки
-				}
-				app.currentLogLines = formattedLines
+		case _, ok := <-watcher.Errors:
+			if !ok {
+				return
 			}
+		case <-refresh:
+			refresh = nil
+			app.gui.Update(func(g *gocui.Gui) error {
+				app.refreshFiles()
+				return nil
+			})
 		}
 	}
-	// Читаем лог через docker cli (если файл не найден или к нему нет доступа) или для compose/podman/kubectl
-	if !readFileContainer || containerizationSystem != "docker" {
-		// Извлекаем имя без статуса в containerId для docker compose и Kubernetes
-		if containerizationSystem == "compose" || containerizationSystem == "kubectl" {
-			parts := strings.Split(containerName, "] ")
-			if len(parts) > 1 {
-				containerId = parts[1]
-			} else {
-				containerId = parts[0]
-			}
+}
+
+// Функция для периодического обновления списка файлов (в режиме ssh события файловой системы недоступны)
+func (app *App) pollFileList(ctx context.Context) {
+	ticker := time.NewTicker(fileListPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			app.gui.Update(func(g *gocui.Gui) error {
+				app.refreshFiles()
+				return nil
+			})
 		}
-		var cmd *exec.Cmd
-		switch containerizationSystem {
-		case "kubectl":
-			// Собираем timezone с учетом смещения UTC
-			sinceTimestamp := app.sinceFilterText + "T00:00:00" + app.timezoneFilter
-			// Формируем команду kubectl с нужными ключами и предварительно извлеченным namespace при выборе пода
-			if app.sshMode {
-				if app.sinceDateFilterMode {
-					cmd = exec.CommandContext(
-						ctx,
-						"ssh", append(app.sshOptions,
-							containerizationSystem, "logs",
-							"--since-time", sinceTimestamp,
-							"--context", app.kubernetesContext, "-n", namespace,
-							"--ignore-errors=true", "--insecure-skip-tls-verify-backend=true",
-							"--all-containers=true", "--prefix=true",
-							"--timestamps=true", "--tail", app.logViewCount, containerId,
-						)...)
-				} else {
-					cmd = exec.CommandContext(
-						ctx,
-						"ssh", append(app.sshOptions,
-							containerizationSystem, "logs",
-							"--context", app.kubernetesContext, "-n", namespace,
-							"--ignore-errors=true", "--insecure-skip-tls-verify-backend=true",
-							"--all-containers=true", "--prefix=true",
-							"--timestamps=true", "--tail", app.logViewCount, containerId,
-						)...)
-				}
-			} else {
-				if app.sinceDateFilterMode {
-					cmd = exec.CommandContext(
-						ctx,
-						containerizationSystem, "logs",
-						"--since-time", sinceTimestamp,
-						"--context", app.kubernetesContext, "-n", namespace,
-						"--ignore-errors=true", "--insecure-skip-tls-verify-backend=true",
-						"--all-containers=true", "--prefix=true",
-						"--timestamps=true", "--tail", app.logViewCount, containerId,
-					)
-				} else {
-					cmd = exec.CommandContext(
-						ctx,
-						containerizationSystem, "logs",
-						"--context", app.kubernetesContext, "-n", namespace,
-						"--ignore-errors=true", "--insecure-skip-tls-verify-backend=true",
-						"--all-containers=true", "--prefix=true",
-						"--timestamps=true", "--tail", app.logViewCount, containerId,
-					)
-				}
-			}
-		case "compose":
-			// Сначала получаем список контейнеров в стеке Compose
-			if newUpdate {
-				containerNameArr := app.getContainersFromCompose(containerId)
-				// Предварительно очищаем карту
-				clear(app.uniquePrefixColorMap)
-				// Заполняем карту уникальных цветов для уникальной покраски названия контейнеров в префиксах compose
-				for _, containerName := range containerNameArr {
-					if containerName != "" {
-						newColor := uniquePrefixColorArr[len(app.uniquePrefixColorMap)%len(uniquePrefixColorArr)]
-						app.uniquePrefixColorMap[containerName] = newColor
-					}
-				}
+	}
+}
+
+// Функция для загрузки текущего списка файлов в горутине с обновлением списка на месте (вызывается в основном потоке интерфейса)
+func (app *App) refreshFiles() {
+	// Пропускаем обновление во время переключения списка или предыдущего обновления
+	if app.fileListRefreshing || !app.keybindingsEnabled {
+		return
+	}
+	app.fileListRefreshing = true
+	selectPath := app.selectPath
+	worker := app.loadWorker()
+	worker.fileListRefresh = true
+	go func() {
+		worker.loadFiles(selectPath)
+		app.gui.Update(func(g *gocui.Gui) error {
+			app.fileListRefreshing = false
+			// Результат не применяется, если за время загрузки выбран другой список
+			if app.selectPath == selectPath && app.keybindingsEnabled {
+				app.applyPending(worker)
 			}
-			sinceTimestamp := app.sinceFilterText + "T00:00:00" + app.timezoneFilter
-			untilTimestamp := app.untilFilterText + "T00:00:00" + app.timezoneFilter
-			if app.sshMode {
-				if app.dockerCompose == "docker compose" {
-					switch {
-					case app.sinceDateFilterMode && app.untilDateFilterMode:
-						cmd = exec.CommandContext(
-							ctx,
-							"ssh", append(app.sshOptions,
-								"docker", "--context", app.dockerContext, "compose", "--project-name", containerId, "logs", "--timestamps", "--no-color",
-								"--since", sinceTimestamp,
-								"--until", untilTimestamp,
-								"--tail", app.logViewCount,
-							)...,
-						)
-					case app.sinceDateFilterMode && !app.untilDateFilterMode:
-						cmd = exec.CommandContext(
-							ctx,
-							"ssh", append(app.sshOptions,
-								"docker", "--context", app.dockerContext, "compose", "--project-name", containerId, "logs", "--timestamps", "--no-color",
-								"--since", sinceTimestamp,
-								"--tail", app.logViewCount,
-							)...,
-						)
-					case !app.sinceDateFilterMode && app.untilDateFilterMode:
-						cmd = exec.CommandContext(
-							ctx,
-							"ssh", append(app.sshOptions,
-								"docker", "--context", app.dockerContext, "compose", "--project-name", containerId, "logs", "--timestamps", "--no-color",
-								"--until", untilTimestamp,
-								"--tail", app.logViewCount,
-							)...,
-						)
-					default:
-						cmd = exec.CommandContext(
-							ctx,
-							"ssh", append(app.sshOptions,
-								"docker", "--context", app.dockerContext, "compose", "--project-name", containerId, "logs", "--timestamps", "--no-color",
-								"--tail", app.logViewCount,
-							)...,
-						)
-					}
-				} else {
-					switch {
-					case app.sinceDateFilterMode && app.untilDateFilterMode:
-						cmd = exec.CommandContext(
-							ctx,
-							"ssh", append(app.sshOptions,
-								app.dockerCompose, "--context", app.dockerContext, "--project-name", containerId, "logs", "--timestamps", "--no-color",
-								"--since", sinceTimestamp,
-								"--until", untilTimestamp,
-								"--tail", app.logViewCount,
-							)...,
-						)
-					case app.sinceDateFilterMode && !app.untilDateFilterMode:
-						cmd = exec.CommandContext(
-							ctx,
-							"ssh", append(app.sshOptions,
-								app.dockerCompose, "--context", app.dockerContext, "--project-name", containerId, "logs", "--timestamps", "--no-color",
-								"--since", sinceTimestamp,
-								"--tail", app.logViewCount,
-							)...,
-						)
-					case !app.sinceDateFilterMode && app.untilDateFilterMode:
-						cmd = exec.CommandContext(
-							ctx,
-							"ssh", append(app.sshOptions,
-								app.dockerCompose, "--context", app.dockerContext, "--project-name", containerId, "logs", "--timestamps", "--no-color",
-								"--until", untilTimestamp,
-								"--tail", app.logViewCount,
-							)...,
-						)
-					default:
-						cmd = exec.CommandContext(
-							ctx,
-							"ssh", append(app.sshOptions,
-								app.dockerCompose, "--context", app.dockerContext, "--project-name", containerId, "logs", "--timestamps", "--no-color",
-								"--tail", app.logViewCount,
-							)...,
-						)
-					}
-				}
-			} else {
-				if app.dockerCompose == "docker compose" {
-					switch {
-					case app.sinceDateFilterMode && app.untilDateFilterMode:
-						cmd = exec.CommandContext(
-							ctx,
-							"docker", "--context", app.dockerContext, "compose", "--project-name", containerId, "logs", "--timestamps", "--no-color",
-							"--since", sinceTimestamp,
-							"--until", untilTimestamp,
-							"--tail", app.logViewCount,
-						)
-					case app.sinceDateFilterMode && !app.untilDateFilterMode:
-						cmd = exec.CommandContext(
-							ctx,
-							"docker", "--context", app.dockerContext, "compose", "--project-name", containerId, "logs", "--timestamps", "--no-color",
-							"--since", sinceTimestamp,
-							"--tail", app.logViewCount,
-						)
-					case !app.sinceDateFilterMode && app.untilDateFilterMode:
-						cmd = exec.CommandContext(
-							ctx,
-							"docker", "--context", app.dockerContext, "compose", "--project-name", containerId, "logs", "--timestamps", "--no-color",
-							"--until", untilTimestamp,
-							"--tail", app.logViewCount,
-						)
-					default:
-						cmd = exec.CommandContext(
-							ctx,
-							"docker", "--context", app.dockerContext, "compose", "--project-name", containerId, "logs", "--timestamps", "--no-color",
-							"--tail", app.logViewCount,
-						)
-					}
-				} else {
-					switch {
-					case app.sinceDateFilterMode && app.untilDateFilterMode:
-						cmd = exec.CommandContext(
-							ctx,
-							app.dockerCompose, "--context", app.dockerContext, "--project-name", containerId, "logs", "--timestamps", "--no-color",
-							"--since", sinceTimestamp,
-							"--until", untilTimestamp,
-							"--tail", app.logViewCount,
-						)
-					case app.sinceDateFilterMode && !app.untilDateFilterMode:
-						cmd = exec.CommandContext(
-							ctx,
-							app.dockerCompose, "--context", app.dockerContext, "--project-name", containerId, "logs", "--timestamps", "--no-color",
-							"--since", sinceTimestamp,
-							"--tail", app.logViewCount,
-						)
-					case !app.sinceDateFilterMode && app.untilDateFilterMode:
-						cmd = exec.CommandContext(
-							ctx,
-							app.dockerCompose, "--context", app.dockerContext, "--project-name", containerId, "logs", "--timestamps", "--no-color",
-							"--until", untilTimestamp,
-							"--tail", app.logViewCount,
-						)
-					default:
-						cmd = exec.CommandContext(
-							ctx,
-							app.dockerCompose, "--context", app.dockerContext, "--project-name", containerId, "logs", "--timestamps", "--no-color",
-							"--tail", app.logViewCount,
-						)
-					}
+			return nil
+		})
+	}()
+}
+
+// Функция для обновления списка файлов на месте с сохранением фильтра списка и выбранного файла
+func (app *App) refreshFileList(logfiles []Logfile) {
+	var selectedPath string
+	if app.selectedFile < len(app.logfiles) {
+		selectedPath = app.logfiles[app.selectedFile].path
+	}
+	row := app.selectedFile - app.startFiles
+	app.logfilesNotFilter = logfiles
+	app.logfiles = app.filterLogFiles(logfiles)
+	// Выбранный файл остается на той же строке окна, а если он удален, курсор остается на той же позиции списка
+	index := slices.IndexFunc(app.logfiles, func(logfile Logfile) bool {
+		return logfile.path == selectedPath
+	})
+	if index < 0 {
+		index = max(min(app.selectedFile, len(app.logfiles)-1), 0)
+	}
+	app.selectedFile = index
+	app.startFiles = max(index-row, 0)
+	if app.testMode {
+		return
+	}
+	app.updateLogsList()
+	app.selectFileByIndex(app.selectedFile - app.startFiles)
+}
+
+func (app *App) loadWinFiles(logPath string) {
+	app.logfiles = nil
+	// Определяем путь по параметру
+	switch logPath {
+	case "ProgramFiles":
+		logPath = app.systemDisk + ":\\Program Files"
+	case "ProgramFiles86":
+		logPath = app.systemDisk + ":\\Program Files (x86)"
+	case "ProgramData":
+		logPath = app.systemDisk + ":\\ProgramData"
+	case "AppDataLocal":
+		logPath = app.systemDisk + ":\\Users\\" + app.userName + "\\AppData\\Local"
+	case "AppDataRoaming":
+		logPath = app.systemDisk + ":\\Users\\" + app.userName + "\\AppData\\Roaming"
+	case "WinCustomPath":
+		logPath = app.customPath
+	}
+	// Массив для хранений списка файлов
+	var files []string
+	// Получаем список корневых директорий
+	rootDirs, _ := os.ReadDir(logPath)
+	// Проверяем файлы внутри customPath
+	if logPath == app.customPath {
+		for _, rootFile := range rootDirs {
+			if !rootFile.IsDir() {
+				if strings.HasSuffix(strings.ToLower(rootFile.Name()), ".log") {
+					files = append(files, rootFile.Name())
 				}
 			}
-		// Podman and Docker case
-		default:
-			// Формируем опции для выполнения команды
-			cmdOptions := []string{}
-			// #38 Добавляем название контекста с проверкой флага для Podman
-			if containerizationSystem == "docker" {
-				cmdOptions = append(cmdOptions, "--context", app.dockerContext)
-			} else if containerizationSystem == "podman" && app.podmanContext != "" {
-				cmdOptions = append(cmdOptions, "--context", app.podmanContext)
-			}
-			// Добавляем фильтрацию по времени
-			sinceTimestamp := app.sinceFilterText + "T00:00:00" + app.timezoneFilter
-			untilTimestamp := app.untilFilterText + "T00:00:00" + app.timezoneFilter
-			switch {
-			case app.sinceDateFilterMode && app.untilDateFilterMode:
-				cmdOptions = append(
-					cmdOptions, "logs", "--timestamps", "--tail", app.logViewCount,
-					"--since", sinceTimestamp,
-					"--until", untilTimestamp,
-				)
-			case app.sinceDateFilterMode && !app.untilDateFilterMode:
-				cmdOptions = append(
-					cmdOptions, "logs", "--timestamps", "--tail", app.logViewCount,
-					"--since", sinceTimestamp,
-				)
-			case !app.sinceDateFilterMode && app.untilDateFilterMode:
-				cmdOptions = append(
-					cmdOptions, "logs", "--timestamps", "--tail", app.logViewCount,
-					"--until", untilTimestamp,
-				)
-			default:
-				cmdOptions = append(
-					cmdOptions, "logs", "--timestamps", "--tail", app.logViewCount,
-				)
-			}
-			// Добавляем ssh параметры
-			if app.sshMode {
-				cmdOptions = append([]string{containerizationSystem}, cmdOptions...)
-				cmdOptions = append(app.sshOptions, cmdOptions...)
-				// ssh sshOptions containerizationSystem cmdOptions containerId
-				cmd = exec.CommandContext(
-					ctx,
-					"ssh",
-					append(
-						cmdOptions,
-						containerId,
-					)...,
-				)
-			} else {
-				// containerizationSystem cmdOptions containerId
-				cmd = exec.CommandContext(
-					ctx,
-					containerizationSystem,
-					append(
-						cmdOptions,
-						containerId,
-					)...,
-				)
-			}
 		}
-		if app.logging {
-			slog.Info(cmd.String(), "action", "Reading "+containerName+" container logs")
-		}
-		// Ожидаем выполнение в течение 2-х секунд
-		cmd.WaitDelay = 2 * time.Second
-		// Храним байты вывода
-		var stdoutBytes, stderrBytes []byte
-		var stdoutErr, stderrErr error
-		// Храним комбинированный вывод двух потоков
-		var combined []dockerLogLines
-		switch {
-		// Читаем только один поток в режиме stdout для Docker или compose и kubectl
-		case app.dockerStreamMode == "stdout" || containerizationSystem == "compose" || containerizationSystem == "kubectl":
-			// Читаем стандартный вывод
-			stdoutPipe, _ := cmd.StdoutPipe()
-			cmd.Start()
-			stdoutBytes, stdoutErr = io.ReadAll(stdoutPipe)
-			stdoutLines := strings.Split(string(stdoutBytes), "\n")
-			// Удаляем последнюю пустую строку
-			if len(stdoutLines) > 0 && stdoutLines[len(stdoutLines)-1] == "" {
-				stdoutLines = stdoutLines[0 : len(stdoutLines)-1]
-			}
-			// Проверяем stdout на ошибки, если в выводе одна строка (например, 502 Bad Gateway в kubectl)
-			if len(stdoutLines) <= 1 {
-				lastLineContext := ""
-				// Проверяем на пустую строку
-				if len(stdoutLines) > 0 && len(stdoutLines[0]) > 0 {
-					lastLineContext = stdoutLines[0]
-				}
-				combined = append(combined, dockerLogLines{
-					isError:   false,
-					timestamp: time.Now(),
-					content:   lastLineContext,
-				})
-			} else {
-				// Формируем итоговый массив
-				for _, line := range stdoutLines {
-					// Пропускаем пустые строки
-					if strings.TrimSpace(line) == "" {
-						continue
-					}
-					var ts time.Time
-					var err error
-					// Извлекаем timestamp
-					switch containerizationSystem {
-					case "compose":
-						// Сначала извлекаем имя сервиса
-						parts1 := strings.SplitN(line, " | ", 2)
-						// Затем извлекаем timestamp
-						parts2 := strings.SplitN(parts1[1], " ", 2)
-						tsStr := strings.TrimSpace(parts2[0])
-						ts, err = time.Parse(time.RFC3339Nano, tsStr)
-					case "kubectl":
-						// Сначала извлекаем префикс (название пода и контейнера в формате [pod/<podName>/<containerName>])
-						parts1 := strings.SplitN(line, "] ", 2)
-						// Затем извлекаем timestamp
-						parts2 := strings.SplitN(parts1[1], " ", 2)
-						tsStr := strings.TrimSpace(parts2[0])
-						ts, err = time.Parse(time.RFC3339Nano, tsStr)
-					default:
-						// Извлекаем время из префикса docker/podman
-						ts, err = parseTimestamp(line)
-					}
+	}
+	// Доступ к срезу files из нескольких горутин
+	var mu sync.Mutex
+	// Группа ожидания для отслеживания завершения всех горутин
+	var wg sync.WaitGroup
+	// Ищем файлы с помощью WalkDir в Windows
+	for _, rootDir := range rootDirs {
+		// Проверяем, является ли текущий элемент директорией
+		if rootDir.IsDir() {
+			// Увеличиваем счетчик ожидаемых горутин
+			wg.Add(1)
+			go func(dir string) {
+				// Уменьшаем счетчик горутин после завершения выполнения текущей функции
+				defer wg.Done()
+				// Рекурсивно обходим все файлы и подкаталоги в текущей директории
+				err := filepath.WalkDir(filepath.Join(logPath, dir), func(path string, d os.DirEntry, err error) error {
 					if err != nil {
-						continue
+						// Игнорируем ошибки, чтобы не прерывать поиск
+						return nil
 					}
-					combined = append(combined, dockerLogLines{
-						isError:   false,
-						timestamp: ts,
-						content:   line,
-					})
-				}
-				// Сортируем вывод по timestamp для compose
-				if containerizationSystem == "compose" {
-					sort.Slice(
-						combined,
-						func(i, j int) bool {
-							return combined[i].timestamp.Before(combined[j].timestamp)
-						},
-					)
-				}
-			}
-		case app.dockerStreamMode == "stderr":
-			// Читаем вывод ошибок
-			stderrPipe, _ := cmd.StderrPipe()
-			_ = cmd.Start()
-			stderrBytes, stderrErr = io.ReadAll(stderrPipe)
-			stderrLines := strings.Split(string(stderrBytes), "\n")
-			// Формируем итоговый массив
-			for _, line := range stderrLines {
-				if strings.TrimSpace(line) == "" {
-					continue
-				}
-				ts, err := parseTimestamp(line)
-				if err != nil {
-					continue
-				}
-				combined = append(combined, dockerLogLines{
-					isError:   true,
-					timestamp: ts,
-					content:   line,
-				})
-			}
-		default:
-			// Читаем стандартный вывод
-			stdoutPipe, _ := cmd.StdoutPipe()
-			// Читаем вывод ошибок
-			stderrPipe, _ := cmd.StderrPipe()
-			// Запускаем команду
-			_ = cmd.Start()
-			// Читаем два потока параллельно, чтобы не блокировать
-			var wg sync.WaitGroup
-			wg.Add(2)
-			go func() {
-				defer wg.Done()
-				stdoutBytes, stdoutErr = io.ReadAll(stdoutPipe)
-			}()
-			go func() {
-				defer wg.Done()
-				stderrBytes, stderrErr = io.ReadAll(stderrPipe)
-			}()
-			wg.Wait()
-			_ = cmd.Wait()
-			// Обработка ошибок чтения
-			if stdoutErr != nil || stderrErr != nil {
-				if !app.testMode {
-					v, _ := app.gui.View("logs")
-					v.Clear()
-					fmt.Fprintln(v, "\033[31mError getting logs from", containerName, "(id:", containerId, ")", "container.\033[0m")
-					return
-				} else {
-					log.Print("Error: getting logs from ", containerName, " (id:", containerId, ")", " container.")
-				}
-			}
-			// Получаем 2 массива вывода
-			stdoutLines := strings.Split(string(stdoutBytes), "\n")
-			stderrLines := strings.Split(string(stderrBytes), "\n")
-			// Объединяем два массив
-			for _, line := range stdoutLines {
-				if strings.TrimSpace(line) == "" {
-					continue
-				}
-				ts, err := parseTimestamp(line)
-				if err != nil {
-					continue
-				}
-				combined = append(combined, dockerLogLines{
-					isError:   false,
-					timestamp: ts,
-					content:   line,
+					// Проверяем, что текущий элемент не является директорией и имеет расширение .log
+					if !d.IsDir() && strings.HasSuffix(strings.ToLower(d.Name()), ".log") {
+						// Получаем относительный путь (без корневого пути logPath)
+						relPath, _ := filepath.Rel(logPath, path)
+						// Используем мьютекс для добавления файла в срез
+						mu.Lock()
+						files = append(files, relPath)
+						mu.Unlock()
+					}
+					return nil
 				})
-			}
-			for _, line := range stderrLines {
-				if strings.TrimSpace(line) == "" {
-					continue
-				}
-				ts, err := parseTimestamp(line)
 				if err != nil {
-					continue
+					return
 				}
-				combined = append(combined, dockerLogLines{
-					isError:   true,
-					timestamp: ts,
-					content:   line,
-				})
-			}
-			// Cортируем итоговый массив по timestamp
-			sort.Slice(
-				combined,
-				func(i, j int) bool {
-					return combined[i].timestamp.Before(combined[j].timestamp)
-				},
+			}(
+				// Передаем имя текущей директории в горутину
+				rootDir.Name(),
 			)
 		}
-		// Обновляем префиксы
-		var finalLines []string
-		for _, entry := range combined {
-			entryLine := entry.content
-			switch containerizationSystem {
-			case "compose":
-				prefixIndex := strings.Index(entryLine, "|")
-				if prefixIndex != -1 {
-					// Удаляем табуляцию из названия контейнера в префиксе compose
-					beforePrefixLine := entryLine[:prefixIndex]
-					beforePrefixLine = strings.TrimSpace(beforePrefixLine)
-					// Удаляем индекс разделителя "|"
-					afterPrefixLine := entryLine[prefixIndex+1:]
-					// Заключаем название контейнера в квадратные скобки
-					entryLine = "[" + beforePrefixLine + "]" + afterPrefixLine
-				}
-			case "kubectl":
-				// Удаляем префикс названия типа объекта "pod/"
-				entryLine = strings.Replace(entryLine, "pod/", "", 1)
-			}
-			// Удаляем из строки timestamp
-			if !app.timestampDocker {
-				entryLine = removeTimestamp(entryLine, containerizationSystem)
+	}
+	// Ждем завершения всех запущенных горутин
+	wg.Wait()
+	// Объединяем все пути в одну строку, разделенную символом новой строки
+	output := strings.Join(files, "\n")
+	if !app.testMode {
+		// Если список файлов пустой, возвращаем ошибку
+		if len(files) == 0 || (len(files) == 1 && files[0] == "") {
+			app.uiUpdate(func(app *App) {
+				vError, _ := app.gui.View("varLogs")
+				vError.Clear()
+				app.fileSystemFrameColor = app.errorColor
+				vError.FrameColor = app.fileSystemFrameColor
+				vError.Highlight = false
+				fmt.Fprintln(vError, "\033[31mPermission denied (files not found)\033[0m")
+			})
+			return
+		} else {
+			app.uiUpdate(func(app *App) {
+				vError, _ := app.gui.View("varLogs")
+				app.fileSystemFrameColor = app.frameColor
+				if vError.FrameColor != app.frameColor {
+					vError.FrameColor = app.selectedFrameColor
+				}
+				vError.Highlight = true
+			})
+		}
+	} else {
+		if len(files) == 0 || (len(files) == 1 && files[0] == "") {
+			log.Print("Error: files not found in ", logPath)
+		}
+	}
+	serviceMap := make(map[string]bool)
+	scanner := bufio.NewScanner(strings.NewReader(string(output)))
+	for scanner.Scan() {
+		// Формируем полный путь к файлу
+		logFullPath := logPath + "\\" + scanner.Text()
+		// Формируем имя файла для списка
+		logName := scanner.Text()
+		logName = strings.TrimSuffix(logName, ".log")
+		logName = strings.ReplaceAll(logName, "\\", " ")
+		// Получаем информацию о файле
+		fileInfo, err := os.Stat(logFullPath)
+		// Пропускаем файлы, к которым нет доступа
+		if err != nil {
+			continue
+		}
+		// Пропускаем пустые файлы
+		if fileInfo.Size() == 0 {
+			continue
+		}
+		// Проверяем, что полного пути до файла еще нет в списке
+		if logName != "" && !serviceMap[logFullPath] {
+			// Добавляем путь в массив для проверки уникальных путей
+			serviceMap[logFullPath] = true
+			// Добавляем в список
+			app.logfiles = append(app.logfiles, newLogfile(logName, logFullPath, fileInfo))
+		}
+	}
+	sortLogFiles(app.logfiles, app.fileSortMode)
+	logfiles := app.logfiles
+	app.uiUpdate(func(app *App) {
+		app.logfiles = logfiles
+		app.logfilesNotFilter = app.logfiles
+		app.applyFilterList()
+		v, err := app.gui.View("varLogs")
+		if err == nil {
+			curTime := time.Now().Format("02.01.2006 15:04:05")
+			v.Subtitle = "[ " + curTime + " ]"
+		}
+	})
+}
+
+// Префикс списка именованной коллекции файлов в selectPath
+const logCollectionPrefix = "collection:"
+
+// Функция для получения списков файловой системы в порядке переключения (UNIX)
+func (app *App) fileLogLists() []string {
+	lists := []string{"varlog", "customPath"}
+	for _, collection := range app.logCollections {
+		lists = append(lists, logCollectionPrefix+collection.Name)
+	}
+	return append(lists, "home", "descriptor")
+}
+
+// Функция для получения заголовка окна файловой системы для выбранного списка
+func (app *App) fileLogListTitle(selectPath string) string {
+	switch selectPath {
+	case "varlog":
+		return " < System var logs (0) > "
+	case "customPath":
+		return " < Custom path - " + app.customPath + " (0) > "
+	case "home":
+		return " < Users home logs (0) > "
+	case "descriptor":
+		return " < Process descriptor logs (0) > "
+	}
+	return " < Collection - " + strings.TrimPrefix(selectPath, logCollectionPrefix) + " (0) > "
+}
+
+// Функция для поиска коллекции файлов по выбранному списку
+func (app *App) logCollection(selectPath string) (LogCollection, bool) {
+	name, found := strings.CutPrefix(selectPath, logCollectionPrefix)
+	if !found {
+		return LogCollection{}, false
+	}
+	for _, collection := range app.logCollections {
+		if collection.Name == name {
+			return collection, true
+		}
+	}
+	return LogCollection{}, false
+}
+
+// Функция для получения шаблона коллекции (путь без символов шаблона включает все файлы в каталоге)
+func collectionPattern(pattern string) string {
+	pattern = path.Clean(pattern)
+	if !strings.ContainsAny(pattern, "*?[") {
+		pattern = path.Join(pattern, "**")
+	}
+	return pattern
+}
+
+// Функция для получения каталога шаблона до первого элемента с символами шаблона
+func globRoot(pattern string) string {
+	var dirs []string
+	for _, part := range strings.Split(collectionPattern(pattern), "/") {
+		if strings.ContainsAny(part, "*?[") {
+			break
+		}
+		dirs = append(dirs, part)
+	}
+	return strings.Join(dirs, "/") + "/"
+}
+
+// Функция для получения общего каталога всех шаблонов коллекции
+func (c LogCollection) root() string {
+	var common []string
+	for i, pattern := range c.Include {
+		dirs := strings.Split(strings.TrimSuffix(globRoot(pattern), "/"), "/")
+		if i == 0 {
+			common = dirs
+			continue
+		}
+		n := 0
+		for n < len(common) && n < len(dirs) && common[n] == dirs[n] {
+			n++
+		}
+		common = common[:n]
+	}
+	return strings.Join(common, "/") + "/"
+}
+
+// Функция для проверки, что путь соответствует шаблонам коллекции и не исключен
+func (c LogCollection) match(name string) bool {
+	for _, pattern := range c.Exclude {
+		if !strings.Contains(pattern, "/") {
+			if excluded, _ := path.Match(pattern, path.Base(name)); excluded {
+				return false
+			}
+		} else if matchLogGlob(collectionPattern(pattern), name) {
+			return false
+		}
+	}
+	for _, pattern := range c.Include {
+		if matchLogGlob(collectionPattern(pattern), name) {
+			return true
+		}
+	}
+	return false
+}
+
+// Функция для сравнения пути с шаблоном, в котором ** соответствует любому количеству каталогов
+func matchLogGlob(pattern string, name string) bool {
+	var matchParts func(patternParts, nameParts []string) bool
+	matchParts = func(patternParts, nameParts []string) bool {
+		if len(patternParts) == 0 {
+			return len(nameParts) == 0
+		}
+		if patternParts[0] == "**" {
+			for i := 0; i <= len(nameParts); i++ {
+				if matchParts(patternParts[1:], nameParts[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(nameParts) == 0 {
+			return false
+		}
+		if matched, _ := path.Match(patternParts[0], nameParts[0]); !matched {
+			return false
+		}
+		return matchParts(patternParts[1:], nameParts[1:])
+	}
+	return matchParts(strings.Split(pattern, "/"), strings.Split(path.Clean(name), "/"))
+}
+
+// Функция для поиска файлов коллекции с помощью find в каталогах шаблонов и отбора по шаблонам
+func (app *App) findCollectionFiles(c LogCollection) []byte {
+	var output []byte
+	searched := make(map[string]bool)
+	for _, pattern := range c.Include {
+		// Шаблон без символов шаблона может указывать на файл, поэтому каталог передается без завершающего "/"
+		root := globRoot(pattern)
+		if root != "/" {
+			root = strings.TrimSuffix(root, "/")
+		}
+		if searched[root] {
+			continue
+		}
+		searched[root] = true
+		var args []string
+		if c.FollowSymlinks {
+			args = append(args, "-L")
+		}
+		args = append(args, root)
+		if c.MaxDepth > 0 {
+			args = append(args, "-maxdepth", strconv.Itoa(c.MaxDepth))
+		}
+		args = append(args, "-type", "f")
+		// Ошибки при отсутствии прав доступа к части каталогов игнорируются
+		files, _ := app.runCommand("Loading the log files from collection "+c.Name, "find", args...)
+		for _, file := range strings.Split(strings.TrimSpace(string(files)), "\n") {
+			if file != "" && c.match(file) {
+				output = append(output, []byte(file+"\n")...)
+			}
+		}
+	}
+	return output
+}
+
+// Интервал, в течение которого измененный файл отмечается в списке как активный
+const activeFileInterval = time.Minute
+
+// Функция для создания записи списка файлов с размером и временем последнего изменения файла
+func newLogfile(logName string, path string, fileInfo os.FileInfo) Logfile {
+	return Logfile{
+		name:    logName,
+		path:    path,
+		size:    fileInfo.Size(),
+		modTime: fileInfo.ModTime(),
+	}
+}
+
+// Функция для формирования строки списка файлов с колонками возраста последнего изменения и размера (возраст вычисляется при выводе)
+func (l Logfile) listLine(now time.Time) string {
+	age := now.Sub(l.modTime)
+	line := "[" + "\033[34m" + fmt.Sprintf("%4s", formatAge(age)) + "\033[0m" + " " +
+		"\033[36m" + fmt.Sprintf("%8s", formatSize(uint64(l.size))) + "\033[0m" + "] "
+	// Отмечаем файлы, в которые писали за последнюю минуту
+	if age < activeFileInterval {
+		line += "\033[32m●\033[0m "
+	}
+	return line + l.name
+}
+
+// Функция для форматирования возраста изменения файла в краткой форме (45s, 12m, 3h, 5d)
+func formatAge(age time.Duration) string {
+	switch {
+	case age < time.Minute:
+		return strconv.Itoa(int(max(age, 0).Seconds())) + "s"
+	case age < time.Hour:
+		return strconv.Itoa(int(age.Minutes())) + "m"
+	case age < 24*time.Hour:
+		return strconv.Itoa(int(age.Hours())) + "h"
+	}
+	return strconv.Itoa(int(age.Hours()/24)) + "d"
+}
+
+// Функция для сортировки списка файлов по дате изменения (новые выше), имени или размеру (большие выше)
+func sortLogFiles(logfiles []Logfile, mode string) {
+	slices.SortStableFunc(logfiles, func(a, b Logfile) int {
+		switch mode {
+		case "name":
+			return cmp.Compare(removeANSI(a.name), removeANSI(b.name))
+		case "size":
+			return cmp.Compare(b.size, a.size)
+		}
+		return b.modTime.Compare(a.modTime)
+	})
+}
+
+// Функция для переключения сортировки списка файлов (дата изменения, имя, размер)
+func (app *App) sortFileList(g *gocui.Gui, v *gocui.View) error {
+	switch app.fileSortMode {
+	case "name":
+		app.fileSortMode = "size"
+	case "size":
+		app.fileSortMode = "mtime"
+	default:
+		app.fileSortMode = "name"
+	}
+	sortLogFiles(app.logfilesNotFilter, app.fileSortMode)
+	app.applyFilterList()
+	app.showInfo(false, "Sort files by "+app.fileSortMode, 2*time.Second)
+	return nil
+}
+
+// Суффиксы ротированных файлов: номер (syslog.1) или дата (syslog-20240101, app.log.2024-01-01, app-01.01.2024.log)
+var rotationSuffixRegex = regexp.MustCompile(`^(.+?)[.-](?:(\d{1,3})|(\d{8}|\d{4}-\d{2}-\d{2}|\d{2}\.\d{2}\.\d{4}))(\.log)?$`)
+
+// Функция для определения базового файла семейства ротации и порядка файла в семействе (меньше - старше, текущий файл последний)
+func rotationMember(path string) (string, int64) {
+	name := path
+	for _, suffix := range archiveSuffixes {
+		name = strings.TrimSuffix(name, suffix)
+	}
+	match := rotationSuffixRegex.FindStringSubmatch(name)
+	if match == nil {
+		return name, math.MaxInt64
+	}
+	base := match[1] + match[4]
+	if match[2] != "" {
+		n, _ := strconv.ParseInt(match[2], 10, 64)
+		return base, -n
+	}
+	return base, extractDate(match[3]).Unix()
+}
+
+// Функция для объединения ротированных файлов в одну запись списка с текущим файлом семейства
+// (семейство образуется только при наличии файла без суффикса, бинарные журналы не объединяются)
+func groupLogFamilies(logfiles []Logfile) []Logfile {
+	type member struct {
+		index int
+		rank  int64
+	}
+	families := make(map[string][]member)
+	var bases []string
+	for i, logfile := range logfiles {
+		base, rank := rotationMember(logfile.path)
+		if !followableFile(base) {
+			base = logfile.path
+		}
+		if _, found := families[base]; !found {
+			bases = append(bases, base)
+		}
+		families[base] = append(families[base], member{index: i, rank: rank})
+	}
+	grouped := make(map[int]Logfile)
+	skip := make(map[int]bool)
+	for _, base := range bases {
+		members := families[base]
+		if len(members) < 2 {
+			continue
+		}
+		slices.SortStableFunc(members, func(a, b member) int {
+			return cmp.Compare(a.rank, b.rank)
+		})
+		newest := members[len(members)-1]
+		logfile := logfiles[newest.index]
+		// Файлы с номерами или датами без базового файла (Xorg.0.log и Xorg.1.log) не являются ротацией
+		if logfile.path != base {
+			continue
+		}
+		for _, m := range members[:len(members)-1] {
+			logfile.family = append(logfile.family, logfiles[m.index].path)
+			skip[m.index] = true
+		}
+		logfile.name += fmt.Sprintf(" \033[35m(+%d rotated)\033[0m", len(logfile.family))
+		grouped[newest.index] = logfile
+	}
+	result := make([]Logfile, 0, len(logfiles)-len(skip))
+	for i, logfile := range logfiles {
+		if skip[i] {
+			continue
+		}
+		if family, found := grouped[i]; found {
+			logfile = family
+		}
+		result = append(result, logfile)
+	}
+	return result
+}
+
+// Функция для чтения последних строк ротированного файла семейства (архивы распаковываются в процессе)
+func (app *App) readFamilyMember(ctx context.Context, path string) ([]string, error) {
+	count, _ := strconv.Atoi(app.logViewCount)
+	switch {
+	case isArchiveLog(path):
+		archive, err := app.openArchive(ctx, path)
+		if err != nil {
+			return nil, err
+		}
+		defer archive.Close()
+		return readLastLines(archive, count)
+	default:
+		return app.tailFile(ctx, 0, "Reading rotated log file", path)
+	}
+}
+
+// Функция для загрузки предыдущего ротированного файла семейства перед текущим выводом (возвращает true, если загрузка запущена)
+func (app *App) loadFamilyMember() bool {
+	source := &app.fileLogs
+	if app.currentSource != LogSource(source) || len(source.family) == 0 || app.loadInProgress() {
+		return false
+	}
+	path := source.family[len(source.family)-1]
+	app.runLoad(source, func(ctx context.Context, app *App) {
+		lines, err := app.readFamilyMember(ctx, path)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			if app.testMode {
+				log.Print("Error: ", err)
+			}
+			app.uiUpdate(func(app *App) {
+				app.showInfo(true, "Error reading "+path+": "+err.Error(), 3*time.Second)
+			})
+			return
+		}
+		// Отмечаем начало каждого файла семейства в выводе
+		source := &app.fileLogs
+		records := append([]LogRecord{logSeparator(path)}, trimEmptyRecords(parseLogRecords(lines))...)
+		if len(source.history) == 0 {
+			records = append(records, logSeparator(source.path))
+		}
+		source.family = source.family[:len(source.family)-1]
+		source.history = append(records, source.history...)
+		// Записи добавляются в вывод основного потока при применении результата загрузки
+		if app.background {
+			app.pending = append(app.pending, func(app *App) {
+				app.prependLogRecords(records)
+			})
+			return
+		}
+		app.prependLogRecords(records)
+	})
+	return true
+}
+
+// Функция для добавления записей в начало вывода со сдвигом позиции, чтобы видимые строки не сместились
+func (app *App) prependLogRecords(records []LogRecord) {
+	app.currentLogRecords = append(slices.Clone(records), app.currentLogRecords...)
+	if app.testMode {
+		return
+	}
+	filteredRecords, filteredLines, err := app.filterRecords(app.filterRecordsByDate(records))
+	if err != nil {
+		return
+	}
+	app.filteredLogLines = append(app.colorRecords(filteredRecords, filteredLines), app.filteredLogLines...)
+	app.filteredLogRecords = append(filteredRecords, app.filteredLogRecords...)
+	app.logScrollPos += len(filteredLines)
+	app.setLogSubtitle(app.fileLogs.Describe())
+	app.updateLogsView(false)
+}
+
+// Функция для создания записи-разделителя в выводе (начало файла или его ротация)
+func logSeparator(text string) LogRecord {
+	return LogRecord{format: plainRecord, message: "\033[35m⎯⎯⎯\033[0m " + text + " \033[35m⎯⎯⎯\033[0m"}
+}
+
+// Функция для включения или отключения объединения ротированных файлов в окне файловой системы
+func (app *App) switchFamilyView(g *gocui.Gui, v *gocui.View) error {
+	if app.getOS == "windows" {
+		return nil
+	}
+	app.familyView = !app.familyView
+	app.fileLogs.List(app)
+	if app.familyView {
+		app.showInfo(false, "Rotated log families enabled", 2*time.Second)
+	} else {
+		app.showInfo(false, "Rotated log families disabled", 2*time.Second)
+	}
+	return nil
+}
+
+func (app *App) updateLogsList() {
+	v, err := app.gui.View("varLogs")
+	if err != nil {
+		return
+	}
+	v.Clear()
+	now := time.Now()
+	visibleEnd := min(app.startFiles+app.maxVisibleFiles, len(app.logfiles))
+	for i := app.startFiles; i < visibleEnd; i++ {
+		fmt.Fprintln(v, app.logfiles[i].listLine(now))
+	}
+}
+
+func (app *App) nextFileName(v *gocui.View, step int) error {
+	_, viewHeight := v.Size()
+	app.maxVisibleFiles = viewHeight
+	if len(app.logfiles) == 0 {
+		return nil
+	}
+	if app.selectedFile < len(app.logfiles)-1 {
+		app.selectedFile += step
+		if app.selectedFile >= len(app.logfiles) {
+			app.selectedFile = len(app.logfiles) - 1
+		}
+		if app.selectedFile >= app.startFiles+app.maxVisibleFiles {
+			app.startFiles += step
+			if app.startFiles > len(app.logfiles)-app.maxVisibleFiles {
+				app.startFiles = len(app.logfiles) - app.maxVisibleFiles
+			}
+			app.updateLogsList()
+		}
+		if app.selectedFile < app.startFiles+app.maxVisibleFiles {
+			return app.selectFileByIndex(app.selectedFile - app.startFiles)
+		}
+	}
+	return nil
+}
+
+func (app *App) prevFileName(v *gocui.View, step int) error {
+	_, viewHeight := v.Size()
+	app.maxVisibleFiles = viewHeight
+	if len(app.logfiles) == 0 {
+		return nil
+	}
+	if app.selectedFile > 0 {
+		app.selectedFile -= step
+		if app.selectedFile < 0 {
+			app.selectedFile = 0
+		}
+		if app.selectedFile < app.startFiles {
+			app.startFiles -= step
+			if app.startFiles < 0 {
+				app.startFiles = 0
+			}
+			app.updateLogsList()
+		}
+		if app.selectedFile >= app.startFiles {
+			return app.selectFileByIndex(app.selectedFile - app.startFiles)
+		}
+	}
+	return nil
+}
+
+func (app *App) selectFileByIndex(index int) error {
+	v, err := app.gui.View("varLogs")
+	if err != nil {
+		return err
+	}
+	// Обновляем счетчик в заголовке
+	re := regexp.MustCompile(`\s\(.+\) >`)
+	updateTitle := " (0) >"
+	if len(app.logfiles) != 0 {
+		updateTitle = " (" + strconv.Itoa(app.selectedFile+1) + "/" + strconv.Itoa(len(app.logfiles)) + ") >"
+	}
+	v.Title = re.ReplaceAllString(v.Title, updateTitle)
+	if err := v.SetCursor(0, index); err != nil {
+		return nil
+	}
+	return nil
+}
+
+func (app *App) selectFile(g *gocui.Gui, v *gocui.View) error {
+	if v == nil || len(app.logfiles) == 0 {
+		return nil
+	}
+	// Имя берется из списка, так как строка окна содержит колонки возраста и размера
+	_, cy := v.Cursor()
+	index := app.startFiles + cy
+	if index >= len(app.logfiles) {
+		return nil
+	}
+	app.selectLogSource("varLogs", strings.TrimSpace(removeANSI(app.logfiles[index].name)))
+	return nil
+}
+
+// Функция для чтения файла
+func (app *App) loadFileLogs(ctx context.Context, logName string, newUpdate bool) {
+	app.lastContainerizationSystem = ""
+	if logName == "" {
+		return
+	}
+	app.debugStartTime = time.Now()
+	if newUpdate {
+		// В параметре logName имя файла при выборе возвращяется без символов покраски
+		// Получаем путь из массива по имени и сохраняем его для автообновления при смене окна
+		app.fileLogs.path = ""
+		app.fileLogs.family = nil
+		app.fileLogs.history = nil
+		app.releaseFileTail()
+		for _, logfile := range app.logfiles {
+			// Сравниваем имена без покраски
+			logFileName := removeANSI(logfile.name)
+			// Ищем переданное в функцию имя файла и извлекаем путь
+			if logFileName == logName {
+				app.fileLogs.path = logfile.path
+				app.fileLogs.family = slices.Clone(logfile.family)
+				break
+			}
+		}
+	}
+	logFullPath := app.fileLogs.path
+	// Обновляем статус с названием источника журнала (полный путь к файлу)
+	app.setLogSubtitle(app.fileLogs.Describe())
+	// Из открытого локального файла читаются только строки, дописанные с прошлого обновления
+	if !newUpdate && app.fileLogs.tail != nil {
+		if err := app.readFileTail(); err == nil {
+			return
+		}
+		// При ошибке чтения файл загружается заново
+		app.releaseFileTail()
+		app.lastSizeFile = -1
+	}
+	if newUpdate {
+		// Фиксируем новую дату изменения и размер для выбранного файла
+		fileInfo, err := app.statFile(logFullPath)
+		if err != nil {
+			return
+		}
+		fileModTime := fileInfo.ModTime()
+		fileSize := fileInfo.Size()
+		app.lastDateUpdateFile = fileModTime
+		app.lastSizeFile = fileSize
+		app.updateFile = true
+	} else {
+		// Проверяем дату изменения
+		fileInfo, err := app.statFile(logFullPath)
+		if err != nil {
+			return
+		}
+		fileModTime := fileInfo.ModTime()
+		fileSize := fileInfo.Size()
+		// Обновлять файл в горутине, только если есть изменения (проверяем дату модификации и размер)
+		if fileModTime != app.lastDateUpdateFile || fileSize != app.lastSizeFile {
+			app.lastDateUpdateFile = fileModTime
+			app.lastSizeFile = fileSize
+			app.updateFile = true
+		} else {
+			app.updateFile = false
+		}
+	}
+	// Читаем файл, толькое если были изменения
+	if app.updateFile {
+		// Читаем логи в системе Windows
+		if app.getOS == "windows" {
+			decodedOutput, stringErrors := app.loadWinFileLog(logFullPath)
+			if stringErrors != "nil" && !app.testMode {
+				app.showLogError("\033[31mError", stringErrors, "\033[0m")
+				return
+			}
+			if stringErrors != "nil" && app.testMode {
+				log.Print("Error: ", stringErrors)
+			}
+			app.currentLogRecords = parseLogRecords(strings.Split(string(decodedOutput), "\n"))
+		} else {
+			// Читаем логи в системах UNIX (Linux/Darwin/*BSD)
+			switch {
+			// Читаем файлы в формате ASL (Apple System Log)
+			case strings.HasSuffix(logFullPath, "asl"):
+				output, err := app.runCommandContext(ctx, 0, "Reading logs in asl format", "syslog", "-f", logFullPath)
+				// Пропускаем результат загрузки, отмененной выбором другого журнала
+				if ctx.Err() != nil {
+					return
+				}
+				if err != nil && !app.testMode {
+					app.showLogError(" \033[31mError reading log using syslog tool in ASL (Apple System Log) format.\n", err, "\033[0m")
+					return
+				}
+				if err != nil && app.testMode {
+					log.Print("Error: reading log using syslog tool in ASL (Apple System Log) format. ", err)
+				}
+				app.currentLogRecords = parseLogRecords(strings.Split(string(output), "\n"))
+			// Читаем журналы Packet Capture в формате pcap/pcapng
+			case strings.HasSuffix(logFullPath, "pcap") || strings.HasSuffix(logFullPath, "pcapng"):
+				output, err := app.runCommandContext(ctx, 0, "Reading logs in pcap format", "tcpdump", "-n", "-r", logFullPath)
+				if ctx.Err() != nil {
+					return
+				}
+				if err != nil && !app.testMode {
+					app.showLogError(" \033[31mError reading log using tcpdump tool.\n", err, "\033[0m")
+					return
+				}
+				if err != nil && app.testMode {
+					log.Print("Error: reading log using tcpdump tool. ", err)
+				}
+				app.currentLogRecords = parseLogRecords(strings.Split(string(output), "\n"))
+			// Packet Filter (PF) Firewall (OpenBSD)
+			case strings.HasSuffix(logFullPath, "pflog"):
+				output, err := app.runCommandContext(ctx, 0, "Reading logs in pflog format", "tcpdump", "-e", "-n", "-r", logFullPath)
+				if ctx.Err() != nil {
+					return
+				}
+				if err != nil && !app.testMode {
+					app.showLogError(" \033[31mError reading log using tcpdump tool.\n", err, "\033[0m")
+					return
+				}
+				app.currentLogRecords = parseLogRecords(strings.Split(string(output), "\n"))
+			// Читаем архивные логи в формате pcap/pcapng (macOS)
+			case strings.HasSuffix(logFullPath, "pcap.gz") || strings.HasSuffix(logFullPath, "pcapng.gz"):
+				// Создаем временный файл
+				tmpFile, err := os.CreateTemp("", "temp-*.pcap")
+				if err != nil && !app.testMode {
+					app.showLogError(" \033[31mError create temp file.\n", err, "\033[0m")
+					return
+				}
+				// Удаляем временный файл после обработки
+				defer os.Remove(tmpFile.Name())
+				// Распаковываем архив во временный файл (в режиме ssh архив читается с удаленной системы)
+				archive, err := app.openArchive(ctx, logFullPath)
+				if ctx.Err() != nil {
+					return
+				}
+				if err != nil && !app.testMode {
+					app.showLogError(" \033[31mError opening archive log.\n", err, "\033[0m")
+					return
+				}
+				if err != nil {
+					log.Print("Error: opening archive log. ", err)
+					return
+				}
+				_, copyErr := io.Copy(tmpFile, archive)
+				closeErr := archive.Close()
+				if ctx.Err() != nil {
+					tmpFile.Close()
+					return
+				}
+				if err := cmp.Or(copyErr, closeErr); err != nil && !app.testMode {
+					app.showLogError(" \033[31mError decompressing archive log.\n", err, "\033[0m")
+					return
+				}
+				// Закрываем временный файл, чтобы tcpdump мог его открыть
+				if err := tmpFile.Close(); err != nil && !app.testMode {
+					app.showLogError(" \033[31mError closing temp file.\n", err, "\033[0m")
+					return
+				}
+				// Временный файл находится в локальной системе, поэтому tcpdump запускается локально
+				output, err := app.localCmdExecutor().Output(ctx, Command{
+					Name:   "tcpdump",
+					Args:   []string{"-n", "-r", tmpFile.Name()},
+					Action: "Reading logs in pcap format",
+				})
+				if ctx.Err() != nil {
+					return
+				}
+				if err != nil && !app.testMode {
+					app.showLogError(" \033[31mError reading log using tcpdump tool.\n", err, "\033[0m")
+					return
+				}
+				lines := strings.Split(strings.TrimSuffix(string(output), "\n"), "\n")
+				app.currentLogRecords = parseLogRecords(lines)
+			// Читаем архивные логи в формате gz/xz/bz2/zst с распаковкой в процессе
+			case isArchiveLog(logFullPath):
+				archive, err := app.openArchive(ctx, logFullPath)
+				if ctx.Err() != nil {
+					return
+				}
+				if err != nil && !app.testMode {
+					app.showLogError(" \033[31mError opening archive log.\n", err, "\033[0m")
+					return
+				}
+				if err != nil {
+					log.Print("Error: opening archive log. ", err)
+					return
+				}
+				// Читаем последние строки из распакованного потока
+				count, _ := strconv.Atoi(app.logViewCount)
+				lines, err := readLastLines(archive, count)
+				closeErr := archive.Close()
+				if ctx.Err() != nil {
+					return
+				}
+				if err := cmp.Or(err, closeErr); err != nil && !app.testMode {
+					app.showLogError(" \033[31mError decompressing archive log.\n", err, "\033[0m")
+					return
+				}
+				// Выводим содержимое
+				app.currentLogRecords = parseLogRecords(lines)
+			// Читаем бинарные файлы с помощью last для wtmp, а также utmp (OpenBSD) и utx.log (FreeBSD)
+			case strings.Contains(logFullPath, "wtmp") || strings.Contains(logFullPath, "utmp") || strings.Contains(logFullPath, "utx.log"):
+				output, err := app.runCommandContext(ctx, 0, "Reading logs in wtmp/utmp/utx format", "last", "-f", logFullPath)
+				if ctx.Err() != nil {
+					return
+				}
+				if err != nil && !app.testMode {
+					app.showLogError(" \033[31mError reading log using last tool.\n", err, "\033[0m")
+					return
+				}
+				// Разбиваем вывод на строки
+				lines := strings.Split(string(output), "\n")
+				var filteredLines []string
+				// Фильтруем строки, исключая последнюю строку и пустые строки
+				for _, line := range lines {
+					trimmedLine := strings.TrimSpace(line)
+					if trimmedLine != "" && !strings.Contains(trimmedLine, "begins") {
+						filteredLines = append(filteredLines, trimmedLine)
+					}
+				}
+				// Переворачиваем порядок строк
+				for i, j := 0, len(filteredLines)-1; i < j; i, j = i+1, j-1 {
+					filteredLines[i], filteredLines[j] = filteredLines[j], filteredLines[i]
+				}
+				app.currentLogRecords = parseLogRecords(filteredLines)
+			// lastb for btmp
+			case strings.Contains(logFullPath, "btmp"):
+				output, err := app.runCommandContext(ctx, 0, "Reading logs in btmp format", "lastb", "-f", logFullPath)
+				if ctx.Err() != nil {
+					return
+				}
+				if err != nil && !app.testMode {
+					app.showLogError(" \033[31mError reading log using lastb tool.\n", err, "\033[0m")
+					return
+				}
+				lines := strings.Split(string(output), "\n")
+				var filteredLines []string
+				for _, line := range lines {
+					trimmedLine := strings.TrimSpace(line)
+					if trimmedLine != "" && !strings.Contains(trimmedLine, "begins") {
+						filteredLines = append(filteredLines, trimmedLine)
+					}
+				}
+				for i, j := 0, len(filteredLines)-1; i < j; i, j = i+1, j-1 {
+					filteredLines[i], filteredLines[j] = filteredLines[j], filteredLines[i]
+				}
+				app.currentLogRecords = parseLogRecords(filteredLines)
+			// Выводим содержимое из команды lastlog
+			case strings.HasSuffix(logFullPath, "lastlog"):
+				output, err := app.runCommandContext(ctx, 0, "Reading logs in lastlog format", "lastlog")
+				if ctx.Err() != nil {
+					return
+				}
+				if err != nil && !app.testMode {
+					app.showLogError(" \033[31mError reading log using lastlog tool.\n", err, "\033[0m")
+					return
+				}
+				app.currentLogRecords = parseLogRecords(strings.Split(string(output), "\n"))
+			// lastlogin for FreeBSD
+			case strings.HasSuffix(logFullPath, "lastlogin"):
+				output, err := app.runCommandContext(ctx, 0, "Reading logs in lastlogin format", "lastlogin")
+				if ctx.Err() != nil {
+					return
+				}
+				if err != nil && !app.testMode {
+					app.showLogError(" \033[31mError reading log using lastlogin tool.\n", err, "\033[0m")
+					return
+				}
+				app.currentLogRecords = parseLogRecords(strings.Split(string(output), "\n"))
+			default:
+				// Открываем локальный текстовый файл до чтения последних строк, чтобы при обновлении читать только новые строки
+				if !app.sshMode && followableFile(logFullPath) {
+					app.releaseFileTail()
+					app.fileLogs.tail, _ = openFileTail(logFullPath)
+				}
+				var lines []string
+				var err error
+				// Последние строки читаются из открытого файла до позиции, с которой будут читаться новые строки
+				if app.fileLogs.tail != nil {
+					lines, err = app.fileLogs.tail.lastLines(app.logViewCount)
+				} else {
+					lines, err = app.tailFile(ctx, 0, "Reading log file", logFullPath)
+				}
+				if ctx.Err() != nil {
+					return
+				}
+				if err != nil && !app.testMode {
+					app.showLogError(" \033[31mError reading log file.\n", err, "\033[0m")
+					return
+				}
+				app.currentLogRecords = parseLogRecords(lines)
+			}
+		}
+		// Записи загруженных ротированных файлов семейства сохраняются перед текущим файлом при обновлении
+		if len(app.fileLogs.history) > 0 {
+			app.currentLogRecords = append(slices.Clone(app.fileLogs.history), app.currentLogRecords...)
+		}
+		app.showLogLines(newUpdate)
+	}
+}
+
+// Функция для чтения файла с опредилением кодировки в Windows
+func (app *App) loadWinFileLog(filePath string) (output []byte, stringErrors string) {
+	app.lastContainerizationSystem = ""
+	if filePath == "" {
+		return nil, "file not selected"
+	}
+	app.debugStartTime = time.Now()
+	// Открываем файл
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, fmt.Sprintf("open file: %v", err)
+	}
+	defer file.Close()
+	// Получаем информацию о файле
+	stat, err := file.Stat()
+	if err != nil {
+		return nil, fmt.Sprintf("get file stat: %v", err)
+	}
+	// Получаем размер файла
+	fileSize := stat.Size()
+	// Буфер для хранения последних строк
+	var buffer []byte
+	lineCount := 0
+	// Размер буфера чтения (читаем по 1КБ за раз)
+	readSize := int64(1024)
+	// Преобразуем строку с максимальным количеством строк в int
+	logViewCountInt, _ := strconv.Atoi(app.logViewCount)
+	// Читаем файл с конца
+	for fileSize > 0 && lineCount < logViewCountInt {
+		if fileSize < readSize {
+			readSize = fileSize
+		}
+		_, err := file.Seek(fileSize-readSize, 0)
+		if err != nil {
+			return nil, fmt.Sprintf("detect the end of a file via seek: %v", err)
+		}
+		tempBuffer := make([]byte, readSize)
+		_, err = file.Read(tempBuffer)
+		if err != nil {
+			return nil, fmt.Sprintf("read file: %v", err)
+		}
+		buffer = append(tempBuffer, buffer...)
+		lineCount = strings.Count(string(buffer), "\n")
+		fileSize -= int64(readSize)
+	}
+	// Проверка на UTF-16 с BOM
+	utf16withBOM := func(data []byte) bool {
+		return len(data) >= 2 && ((data[0] == 0xFF && data[1] == 0xFE) || (data[0] == 0xFE && data[1] == 0xFF))
+	}
+	// Проверка на UTF-16 LE без BOM
+	utf16withoutBOM := func(data []byte) bool {
+		if len(data)%2 != 0 {
+			return false
+		}
+		for i := 1; i < len(data); i += 2 {
+			if data[i] != 0x00 {
+				return false
+			}
+		}
+		return true
+	}
+	var decodedOutput []byte
+	switch {
+	case utf16withBOM(buffer):
+		// Декодируем UTF-16 с BOM
+		decodedOutput, err = winUnicode.UTF16(winUnicode.LittleEndian, winUnicode.ExpectBOM).NewDecoder().Bytes(buffer)
+		if err != nil {
+			return nil, fmt.Sprintf("decoding from UTF-16 with BOM: %v", err)
+		}
+	case utf16withoutBOM(buffer):
+		// Декодируем UTF-16 LE без BOM
+		decodedOutput, err = winUnicode.UTF16(winUnicode.LittleEndian, winUnicode.IgnoreBOM).NewDecoder().Bytes(buffer)
+		if err != nil {
+			return nil, fmt.Sprintf("decoding from UTF-16 LE without BOM: %v", err)
+		}
+	case utf8.Valid(buffer):
+		// Декодируем UTF-8
+		decodedOutput = buffer
+	default:
+		// Декодируем Windows-1251
+		decodedOutput, err = charmap.Windows1251.NewDecoder().Bytes(buffer)
+		if err != nil {
+			return nil, fmt.Sprintf("decoding from Windows-1251: %v", err)
+		}
+	}
+	return decodedOutput, "nil"
+}
+
+// ---------------------------------------- Docker/Compose/Podman/Kubernetes ----------------------------------------
+
+func (app *App) loadDockerContainer(containerizationSystem string) {
+	if containerizationSystem == "kubernetes" {
+		containerizationSystem = "kubectl"
+	}
+	app.dockerContainers = nil
+	// Получаем версию для проверки, что система контейнеризации установлена
+	var versionArgs []string
+	switch {
+	case containerizationSystem == "compose" && app.dockerCompose == "docker compose":
+		versionArgs = []string{"docker", "compose", "version"}
+	case containerizationSystem == "compose":
+		versionArgs = []string{app.dockerCompose, "version"}
+	default:
+		versionArgs = []string{containerizationSystem, "version"}
+	}
+	// Ограничиваем время выполнения команд (актуально для удаленных команд по ssh)
+	version, err := app.runCommandTimeout(5*time.Second, "Check the binary", versionArgs[0], versionArgs[1:]...)
+	if err != nil && !app.testMode {
+		vError, _ := app.gui.View("docker")
+		vError.Clear()
+		app.dockerFrameColor = app.errorColor
+		vError.FrameColor = app.dockerFrameColor
+		vError.Highlight = false
+		switch containerizationSystem {
+		case "kubectl":
+			if strings.Contains(string(version), "Version:") {
+				// Проверяем вывод kubectl, может быть ошибка подключения к кластеру
+				output, err := app.runCommand("Check the connect to kubernetes cluster", containerizationSystem, "get", "nodes")
+				if err != nil {
+					fmt.Fprintln(vError, "\033[31mError connection to the Kubernetes cluster\033[0m")
+					app.currentLogRecords = parseLogRecords([]string{strings.TrimSpace(string(output) + "\n" + err.Error())})
+					app.applyFilter(false)
+				}
+			} else {
+				fmt.Fprintln(vError, "\033[31m"+containerizationSystem+" not installed (environment not found)\033[0m")
+			}
+		case "compose":
+			fmt.Fprintln(vError, "\033[31m"+app.dockerCompose+" not installed (environment not found)\033[0m")
+		default:
+			fmt.Fprintln(vError, "\033[31m"+containerizationSystem+" not installed (environment not found)\033[0m")
+		}
+		return
+	}
+	if err != nil && app.testMode {
+		switch containerizationSystem {
+		case "kubectl":
+			log.Print("Error:", containerizationSystem+" not installed or no connection to the Kubernetes cluster")
+		case "compose":
+			log.Print("Error:", app.dockerCompose+" not installed (environment not found)")
+		default:
+			log.Print("Error:", containerizationSystem+" not installed (environment not found)")
+		}
+	}
+	var listArgs []string
+	switch containerizationSystem {
+	case "kubectl":
+		// Получаем список подов из k8s
+		listArgs = []string{
+			containerizationSystem, "get", "pods", "--context", app.kubernetesContext, app.kubernetesNamespace,
+			"-o", "jsonpath={range .items[*]}{.metadata.uid} {.metadata.name} {.status.phase} {.metadata.namespace}{\"\\n\"}{end}",
+		}
+	case "compose":
+		// Корректируем положение флага context в команде compose (после docker и перед context)
+		if app.dockerCompose == "docker-compose" {
+			listArgs = []string{app.dockerCompose, "--context", app.dockerContext, "ls", "-a"}
+		} else {
+			listArgs = []string{"docker", "--context", app.dockerContext, "compose", "ls", "-a"}
+		}
+	case "podman":
+		// #38 Отключаем использование контекста в Podman, если он не задан
+		listArgs = []string{containerizationSystem}
+		if app.podmanContext != "" {
+			listArgs = append(listArgs, "--context", app.podmanContext)
+		}
+		listArgs = append(listArgs, "ps", "-a", "--format", "{{.ID}} {{.Names}} {{.State}}")
+	// Docker case
+	default:
+		listArgs = []string{
+			containerizationSystem,
+			"--context", app.dockerContext,
+			"ps", "-a",
+			"--format", "{{.ID}} {{.Names}} {{.State}}",
+		}
+	}
+	output, err := app.runCommandTimeout(5*time.Second, "Loading the container list", listArgs[0], listArgs[1:]...)
+	if !app.testMode {
+		if err != nil {
+			vError, _ := app.gui.View("docker")
+			vError.Clear()
+			app.dockerFrameColor = app.errorColor
+			vError.FrameColor = app.dockerFrameColor
+			vError.Highlight = false
+			if containerizationSystem == "kubectl" {
+				fmt.Fprintln(vError, "\033[31mUnable to access the Kubernetes cluster\033[0m")
+			} else {
+				fmt.Fprintln(vError, "\033[31mAccess denied or "+containerizationSystem+" service stopped\033[0m")
+			}
+			return
+		} else {
+			vError, _ := app.gui.View("docker")
+			vError.Highlight = true
+			app.dockerFrameColor = app.frameColor
+			if vError.FrameColor != app.frameColor {
+				vError.FrameColor = app.selectedFrameColor
+			}
+		}
+	}
+	if err != nil && app.testMode {
+		if containerizationSystem == "kubectl" {
+			log.Print("Error: unable to access the Kubernetes cluster")
+		} else {
+			log.Print("Error: access denied or " + containerizationSystem + " service stopped")
+		}
+	}
+	var containers []string
+	var stringOutput string
+	// Парсим вывод compose
+	if containerizationSystem == "compose" {
+		stacks := strings.Split(strings.TrimSpace(string(output)), "\n")
+		// Удаляем первую строку (элемент массива)
+		stacks = stacks[1:]
+		if len(stacks) != 0 {
+			// Удаляем путь к конфигурационному файлу compose для каждой строки (элемента)
+			for i, e := range stacks {
+				line := strings.Split(e, "/")
+				stacks[i] = line[0]
+			}
+		}
+		containers = stacks
+		stringOutput = strings.Join(containers, "\n")
+	} else {
+		containers = strings.Split(strings.TrimSpace(string(output)), "\n")
+		stringOutput = string(output)
+	}
+	// Проверяем, что список контейнеров не пустой
+	if !app.testMode {
+		if len(containers) == 0 || (len(containers) == 1 && containers[0] == "") {
+			vError, _ := app.gui.View("docker")
+			vError.Clear()
+			vError.Highlight = false
+			fmt.Fprintln(vError, "\033[32mNo running containers\033[0m")
+			return
+		} else {
+			vError, _ := app.gui.View("docker")
+			app.fileSystemFrameColor = app.frameColor
+			if vError.FrameColor != app.frameColor {
+				vError.FrameColor = app.selectedFrameColor
+			}
+			vError.Highlight = true
+		}
+	}
+	// Заполняем структуру dockerContainers (название и статус)
+	serviceMap := make(map[string]bool)
+	scanner := bufio.NewScanner(strings.NewReader(stringOutput))
+	for scanner.Scan() {
+		idName := scanner.Text()
+		parts := strings.Fields(idName)
+		if idName != "" && !serviceMap[idName] {
+			serviceMap[idName] = true
+			var containerName string
+			var containerStatus string
+			if containerizationSystem == "compose" {
+				// Извлекаем имя стеке из первого параметра (в compose отсутствует id)
+				containerName = parts[0]
+				// Собираем все статусы в одну строку
+				containerStatus = strings.Join(parts[1:], " ")
+			} else {
+				containerName = parts[1]
+				containerStatus = parts[2]
+			}
+			composeStatus := containerStatus
+			// Проверяем статус для покраски
+			switch {
+			case strings.HasPrefix(strings.ToLower(containerStatus), "running") ||
+				strings.HasPrefix(strings.ToLower(containerStatus), "succe"):
+				containerStatus = "\033[32m"
+			case strings.HasPrefix(strings.ToLower(containerStatus), "pending") ||
+				strings.HasPrefix(strings.ToLower(containerStatus), "pause") ||
+				strings.HasPrefix(strings.ToLower(containerStatus), "restart") ||
+				strings.Contains(strings.ToLower(containerStatus), "exited") && strings.Contains(strings.ToLower(containerStatus), "running"):
+				containerStatus = "\033[33m"
+			default:
+				containerStatus = "\033[31m"
+			}
+			rawContainerName := containerName
+			if containerizationSystem == "compose" {
+				// Извлекаем количество запущенных контейнеров из статуса
+				var runContainersInt int
+				runContainersArr := strings.Split(composeStatus, "running(")
+				if len(runContainersArr) > 1 {
+					runContainersArr = strings.Split(runContainersArr[1], ")")
+					runContainersInt, err = strconv.Atoi(runContainersArr[0])
+					if err != nil {
+						runContainersInt = 0
+					}
+				} else {
+					runContainersArr = []string{"0"}
+					runContainersInt = 0
+				}
+				// Извлекаем количество остановленных и перезапускающихся контейнеров из статуса
+				var exitContainersInt int
+				var restartContainersInt int
+				exitContainersArr := strings.Split(composeStatus, "exited(")
+				if len(exitContainersArr) > 1 {
+					exitContainersArr = strings.Split(exitContainersArr[1], ")")
+					exitContainersInt, err = strconv.Atoi(exitContainersArr[0])
+					if err != nil {
+						exitContainersInt = 0
+					}
+				} else {
+					exitContainersInt = 0
+				}
+				restartContainersArr := strings.Split(composeStatus, "restarting(")
+				if len(restartContainersArr) > 1 {
+					restartContainersArr = strings.Split(restartContainersArr[1], ")")
+					restartContainersInt, err = strconv.Atoi(restartContainersArr[0])
+					if err != nil {
+						restartContainersInt = 0
+					}
+				} else {
+					restartContainersInt = 0
+				}
+				allContainers := strconv.Itoa(runContainersInt + exitContainersInt + restartContainersInt)
+				containerName = "[" + runContainersArr[0] + " of " + allContainers + "] " + containerStatus + containerName + "\033[0m"
+			} else {
+				containerName = containerStatus + containerName + "\033[0m"
+			}
+			// Фиксируем название namespace для k8s
+			var namespace string
+			if containerizationSystem != "kubectl" || parts[3] == "" {
+				namespace = ""
+			} else {
+				namespace = parts[3]
+			}
+			app.dockerContainers = append(app.dockerContainers, DockerContainers{
+				name:      containerName,
+				rawName:   rawContainerName,
+				id:        parts[0],
+				namespace: namespace,
+			})
+		}
+	}
+	sort.Slice(app.dockerContainers, func(i, j int) bool {
+		return app.dockerContainers[i].name < app.dockerContainers[j].name
+	})
+	if !app.testMode {
+		app.dockerContainersNotFilter = app.dockerContainers
+		app.applyFilterList()
+	}
+}
+
+func (app *App) updateDockerContainerList() {
+	v, err := app.gui.View("docker")
+	if err != nil {
+		return
+	}
+	v.Clear()
+	visibleEnd := min(app.startDockerContainers+app.maxVisibleDockerContainers, len(app.dockerContainers))
+	for i := app.startDockerContainers; i < visibleEnd; i++ {
+		fmt.Fprintln(v, app.dockerContainers[i].name)
+	}
+}
+
+func (app *App) nextDockerContainer(v *gocui.View, step int) error {
+	_, viewHeight := v.Size()
+	app.maxVisibleDockerContainers = viewHeight
+	if len(app.dockerContainers) == 0 {
+		return nil
+	}
+	if app.selectedDockerContainer < len(app.dockerContainers)-1 {
+		app.selectedDockerContainer += step
+		if app.selectedDockerContainer >= len(app.dockerContainers) {
+			app.selectedDockerContainer = len(app.dockerContainers) - 1
+		}
+		if app.selectedDockerContainer >= app.startDockerContainers+app.maxVisibleDockerContainers {
+			app.startDockerContainers += step
+			if app.startDockerContainers > len(app.dockerContainers)-app.maxVisibleDockerContainers {
+				app.startDockerContainers = len(app.dockerContainers) - app.maxVisibleDockerContainers
+			}
+			app.updateDockerContainerList()
+		}
+		if app.selectedDockerContainer < app.startDockerContainers+app.maxVisibleDockerContainers {
+			return app.selectDockerByIndex(app.selectedDockerContainer - app.startDockerContainers)
+		}
+	}
+	return nil
+}
+
+func (app *App) prevDockerContainer(v *gocui.View, step int) error {
+	_, viewHeight := v.Size()
+	app.maxVisibleDockerContainers = viewHeight
+	if len(app.dockerContainers) == 0 {
+		return nil
+	}
+	if app.selectedDockerContainer > 0 {
+		app.selectedDockerContainer -= step
+		if app.selectedDockerContainer < 0 {
+			app.selectedDockerContainer = 0
+		}
+		if app.selectedDockerContainer < app.startDockerContainers {
+			app.startDockerContainers -= step
+			if app.startDockerContainers < 0 {
+				app.startDockerContainers = 0
+			}
+			app.updateDockerContainerList()
+		}
+		if app.selectedDockerContainer >= app.startDockerContainers {
+			return app.selectDockerByIndex(app.selectedDockerContainer - app.startDockerContainers)
+		}
+	}
+	return nil
+}
+
+func (app *App) selectDockerByIndex(index int) error {
+	v, err := app.gui.View("docker")
+	if err != nil {
+		return err
+	}
+	// Обновляем счетчик в заголовке
+	re := regexp.MustCompile(`\s\(.+\) >`)
+	updateTitle := " (0) >"
+	if len(app.dockerContainers) != 0 {
+		updateTitle = " (" + strconv.Itoa(app.selectedDockerContainer+1) + "/" + strconv.Itoa(len(app.dockerContainers)) + ") >"
+	}
+	v.Title = re.ReplaceAllString(v.Title, updateTitle)
+	if err := v.SetCursor(0, index); err != nil {
+		return nil
+	}
+	return nil
+}
+
+func (app *App) selectDocker(g *gocui.Gui, v *gocui.View) error {
+	if v == nil || len(app.dockerContainers) == 0 {
+		return nil
+	}
+	_, cy := v.Cursor()
+	line, err := v.Line(cy)
+	if err != nil {
+		return err
+	}
+	app.selectLogSource("docker", strings.TrimSpace(line))
+	return nil
+}
+
+func (app *App) loadDockerLogs(ctx context.Context, containerName string, newUpdate bool) {
+	// Прерываем выполнение функции, если имя контейнера пустое (при выборе пустого поля с помощью мыши)
+	if containerName == "" {
+		return
+	}
+	app.debugStartTime = time.Now()
+	// Сохраняем систему контейнеризации, id контейнера и namespace для подов k8s для автообновления при смене окна
+	if newUpdate {
+		app.containerLogs.system = app.selectContainerizationSystem
+		app.containerLogs.name = containerName
+		app.containerLogs.id = ""
+		app.kubernetesLogs.namespace = ""
+		for _, dockerContainer := range app.dockerContainers {
+			dockerContainerName := ansiEscape.ReplaceAllString(dockerContainer.name, "")
+			if dockerContainerName == containerName {
+				app.containerLogs.id = dockerContainer.id
+				app.kubernetesLogs.namespace = dockerContainer.namespace
+			}
+		}
+		if app.containerLogs.system == "kubernetes" {
+			app.kubernetesLogs.pod = containerName
+		}
+	}
+	containerizationSystem := app.containerLogs.system
+	containerId := app.containerLogs.id
+	namespace := app.kubernetesLogs.namespace
+	// Фиксируем систему контейнеризации для покраски вывода
+	app.lastContainerizationSystem = containerizationSystem
+	// Обновляем статус с названием источника журнала (имя контейнера)
+	if containerizationSystem == "kubernetes" {
+		app.setLogSubtitle(app.kubernetesLogs.Describe())
+	} else {
+		app.setLogSubtitle(app.containerLogs.Describe())
+	}
+	if containerizationSystem == "kubernetes" {
+		containerizationSystem = "kubectl"
+	}
+	// Читаем журналы Docker из файловой системы в формате JSON (если не отключено флагом и docker context default)
+	var readFileContainer bool
+	if containerizationSystem == "docker" && !app.dockerStreamLogs && app.dockerContext == "default" {
+		// Получаем путь к журналу контейнера в файловой системе по id с помощью метода docker cli
+		logFilePathBytes, err := app.runCommandContext(
+			ctx, 5*time.Second,
+			"Reading "+containerName+" container logs from file system",
+			"docker", "inspect", "--format", "{{.LogPath}}", containerId,
+		)
+		// Пропускаем результат загрузки, отмененной выбором другого журнала
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil && !app.testMode {
+			app.showLogError("\033[31mError get log path via docker inspect:", err, "\033[0m")
+			return
+		}
+		if err != nil && app.testMode {
+			log.Print("Error: get log path via docker inspect. ", err)
+		}
+		logFilePath := strings.TrimSpace(string(logFilePathBytes))
+		// Читаем файл с конца
+		lines, err := app.tailFile(ctx, 5*time.Second, "Reading "+containerName+" container logs from file system", logFilePath)
+		if ctx.Err() != nil {
+			return
+		}
+		// Если ошибка чтения, значит нет доступа и переходим к чтению из потока
+		if err != nil && app.dockerStreamLogsStatus == "json-file" {
+			readFileContainer = false
+			app.dockerStreamLogsStatus = app.dockerStreamMode
+			app.dockerStreamLogs = true
+			if !app.testMode {
+				app.showInfo(true, "Access denied to json logs (use root)", 3*time.Second)
+			}
+		} else {
+			readFileContainer = true
+			app.dockerStreamLogsStatus = "json-file"
+		}
+		if readFileContainer {
+			// Проверяем, что есть изменения в файле при повторном считывание
+			if newUpdate {
+				// Фиксируем новую дату изменения и размер для выбранного файла
+				fileInfo, err := app.statFile(logFilePath)
+				if err != nil {
+					return
+				}
+				fileModTime := fileInfo.ModTime()
+				fileSize := fileInfo.Size()
+				app.lastDateUpdateFile = fileModTime
+				app.lastSizeFile = fileSize
+				app.updateFile = true
+			} else {
+				// Проверяем дату изменения
+				fileInfo, err := app.statFile(logFilePath)
+				if err != nil {
+					return
+				}
+				fileModTime := fileInfo.ModTime()
+				fileSize := fileInfo.Size()
+				// Обновлять файл, только если есть изменения (проверяем дату модификации и размер)
+				if fileModTime != app.lastDateUpdateFile || fileSize != app.lastSizeFile {
+					app.lastDateUpdateFile = fileModTime
+					app.lastSizeFile = fileSize
+					app.updateFile = true
+				} else {
+					app.updateFile = false
+				}
+			}
+			// Читаем файл, толькое если были изменения
+			if app.updateFile {
+				var records []LogRecord
+				// Обрабатываем вывод в формате JSON построчно
+				for _, line := range lines {
+					// JSON-структура для парсинга
+					var jsonData map[string]any
+					err := json.Unmarshal([]byte(line), &jsonData)
+					if err != nil {
+						continue
+					}
+					// Извлекаем JSON данные
+					stream, _ := jsonData["stream"].(string)
+					timeStr, _ := jsonData["time"].(string)
+					logMessage, _ := jsonData["log"].(string)
+					// Проверяем режим вывода потоков и пропускаем лишние строки
+					// Если текущий режим соответствует стандартному выводу и текущая строка содержит поток ошибки (или наоборот), пропускаем интерацию
+					if app.dockerStreamMode == "stdout" && stream == "stderr" {
+						continue
+					}
+					if app.dockerStreamMode == "stderr" && stream == "stdout" {
+						continue
+					}
+					// Удаляем встроенный экранированный символ переноса строки
+					logMessage = strings.TrimSuffix(logMessage, "\n")
+					// Парсим строку времени в объект time.Time
+					parsedTime, err := time.Parse(time.RFC3339Nano, timeStr)
+					if err == nil {
+						// Форматируем дату в формате: YYYY-MM-DDTHH:MM:SS.MS(x9)Z
+						timeStr = parsedTime.Format("2006-01-02T15:04:05.000000000Z")
+					}
+					records = append(records, LogRecord{
+						format:    containerRecord,
+						timestamp: parsedTime,
+						timeText:  timeStr,
+						level:     parseLevel(logMessage),
+						stream:    stream,
+						message:   logMessage,
+					})
+				}
+				app.currentLogRecords = records
+			}
+		}
+	}
+	// Читаем лог через docker cli (если файл не найден или к нему нет доступа) или для compose/podman/kubectl
+	if !readFileContainer || containerizationSystem != "docker" {
+		// Извлекаем имя без статуса в containerId для docker compose и Kubernetes
+		if containerizationSystem == "compose" || containerizationSystem == "kubectl" {
+			parts := strings.Split(containerName, "] ")
+			if len(parts) > 1 {
+				containerId = parts[1]
+			} else {
+				containerId = parts[0]
+			}
+		}
+		// Сначала получаем список контейнеров в стеке Compose
+		if containerizationSystem == "compose" && newUpdate {
+			containerNameArr := app.getContainersFromCompose(containerId)
+			// Предварительно очищаем карту
+			clear(app.uniquePrefixColorMap)
+			// Заполняем карту уникальных цветов для уникальной покраски названия контейнеров в префиксах compose
+			for _, containerName := range containerNameArr {
+				if containerName != "" {
+					newColor := uniquePrefixColorArr[len(app.uniquePrefixColorMap)%len(uniquePrefixColorArr)]
+					app.uniquePrefixColorMap[containerName] = newColor
+				}
+			}
+		}
+		logArgs := app.containerLogArgs(containerizationSystem, containerId, namespace, app.logViewCount, false)
+		// Ограничиваем время выполнения команды 5-ю секундами
+		logCmd := Command{
+			Name:    logArgs[0],
+			Args:    logArgs[1:],
+			Action:  "Reading " + containerName + " container logs",
+			Timeout: 5 * time.Second,
+		}
+		// Храним байты вывода
+		var stdoutBytes, stderrBytes []byte
+		var stdoutErr, stderrErr error
+		// Храним комбинированный вывод двух потоков
+		var combined []LogRecord
+		switch {
+		// Читаем только один поток в режиме stdout для Docker или compose и kubectl
+		case app.dockerStreamMode == "stdout" || containerizationSystem == "compose" || containerizationSystem == "kubectl":
+			// Читаем стандартный вывод
+			proc, err := app.cmdExecutor().Start(ctx, logCmd)
+			if err == nil {
+				stdoutBytes, stdoutErr = io.ReadAll(proc.Stdout)
+				_ = proc.Wait()
+			}
+			stdoutLines := strings.Split(string(stdoutBytes), "\n")
+			// Удаляем последнюю пустую строку
+			if len(stdoutLines) > 0 && stdoutLines[len(stdoutLines)-1] == "" {
+				stdoutLines = stdoutLines[0 : len(stdoutLines)-1]
+			}
+			// Проверяем stdout на ошибки, если в выводе одна строка (например, 502 Bad Gateway в kubectl)
+			if len(stdoutLines) <= 1 {
+				lastLineContext := ""
+				// Проверяем на пустую строку
+				if len(stdoutLines) > 0 && len(stdoutLines[0]) > 0 {
+					lastLineContext = stdoutLines[0]
+				}
+				combined = append(combined, LogRecord{
+					format:    containerRecord,
+					timestamp: time.Now(),
+					message:   lastLineContext,
+				})
+			} else {
+				// Формируем итоговый массив
+				for _, line := range stdoutLines {
+					// Пропускаем пустые строки
+					if strings.TrimSpace(line) == "" {
+						continue
+					}
+					// Извлекаем timestamp, префикс compose/kubectl и сообщение
+					record, ok := parseContainerRecord(line, containerizationSystem, false)
+					if !ok {
+						continue
+					}
+					combined = append(combined, record)
+				}
+				// Сортируем вывод по timestamp для compose
+				if containerizationSystem == "compose" {
+					sort.Slice(
+						combined,
+						func(i, j int) bool {
+							return combined[i].timestamp.Before(combined[j].timestamp)
+						},
+					)
+				}
+			}
+		case app.dockerStreamMode == "stderr":
+			// Читаем вывод ошибок (стандартный вывод пропускаем)
+			logCmd.Stderr = true
+			proc, err := app.cmdExecutor().Start(ctx, logCmd)
+			if err == nil {
+				go func() { _, _ = io.Copy(io.Discard, proc.Stdout) }()
+				stderrBytes, stderrErr = io.ReadAll(proc.Stderr)
+				_ = proc.Wait()
+			}
+			stderrLines := strings.Split(string(stderrBytes), "\n")
+			// Формируем итоговый массив
+			for _, line := range stderrLines {
+				if strings.TrimSpace(line) == "" {
+					continue
+				}
+				record, ok := parseContainerRecord(line, containerizationSystem, true)
+				if !ok {
+					continue
+				}
+				combined = append(combined, record)
+			}
+		default:
+			// Читаем стандартный вывод и вывод ошибок
+			logCmd.Stderr = true
+			proc, err := app.cmdExecutor().Start(ctx, logCmd)
+			if err != nil {
+				stdoutErr = err
+			} else {
+				// Читаем два потока параллельно, чтобы не блокировать
+				var wg sync.WaitGroup
+				wg.Add(2)
+				go func() {
+					defer wg.Done()
+					stdoutBytes, stdoutErr = io.ReadAll(proc.Stdout)
+				}()
+				go func() {
+					defer wg.Done()
+					stderrBytes, stderrErr = io.ReadAll(proc.Stderr)
+				}()
+				wg.Wait()
+				_ = proc.Wait()
+			}
+			if ctx.Err() != nil {
+				return
+			}
+			// Обработка ошибок чтения
+			if stdoutErr != nil || stderrErr != nil {
+				if !app.testMode {
+					app.showLogError("\033[31mError getting logs from", containerName, "(id:", containerId, ")", "container.\033[0m")
+					return
+				} else {
+					log.Print("Error: getting logs from ", containerName, " (id:", containerId, ")", " container.")
+				}
+			}
+			// Получаем 2 массива вывода
+			stdoutLines := strings.Split(string(stdoutBytes), "\n")
+			stderrLines := strings.Split(string(stderrBytes), "\n")
+			// Объединяем два массив
+			for _, line := range stdoutLines {
+				if strings.TrimSpace(line) == "" {
+					continue
+				}
+				record, ok := parseContainerRecord(line, containerizationSystem, false)
+				if !ok {
+					continue
+				}
+				combined = append(combined, record)
+			}
+			for _, line := range stderrLines {
+				if strings.TrimSpace(line) == "" {
+					continue
+				}
+				record, ok := parseContainerRecord(line, containerizationSystem, true)
+				if !ok {
+					continue
+				}
+				combined = append(combined, record)
+			}
+			// Cортируем итоговый массив по timestamp
+			sort.Slice(
+				combined,
+				func(i, j int) bool {
+					return combined[i].timestamp.Before(combined[j].timestamp)
+				},
+			)
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		app.currentLogRecords = combined
+	}
+	// Обновляем фильтр и делиметр всегда для потоков ИЛИ если есть изменения в файле при его чтение
+	if !readFileContainer || (readFileContainer && app.updateFile) || containerizationSystem != "docker" {
+		app.showLogLines(newUpdate)
+	}
+}
+
+// Функция для формирования команды чтения журнала контейнера (tail - количество последних строк, follow - чтение новых строк из потока)
+func (app *App) containerLogArgs(containerizationSystem string, containerId string, namespace string, tail string, follow bool) []string {
+	var logArgs []string
+	switch containerizationSystem {
+	case "kubectl":
+		// Формируем команду kubectl с нужными ключами и предварительно извлеченным namespace при выборе пода
+		logArgs = []string{containerizationSystem, "logs"}
+		if app.sinceDateFilterMode {
+			// Собираем timezone с учетом смещения UTC
+			logArgs = append(logArgs, "--since-time", app.sinceFilterText+"T00:00:00"+app.timezoneFilter)
+		}
+		if follow {
+			logArgs = append(logArgs, "--follow=true")
+		}
+		logArgs = append(logArgs,
+			"--context", app.kubernetesContext, "-n", namespace,
+			"--ignore-errors=true", "--insecure-skip-tls-verify-backend=true",
+			"--all-containers=true", "--prefix=true",
+			"--timestamps=true", "--tail", tail, containerId,
+		)
+	case "compose":
+		// Корректируем положение флага context в команде compose (после docker и перед context)
+		if app.dockerCompose == "docker compose" {
+			logArgs = []string{"docker", "--context", app.dockerContext, "compose"}
+		} else {
+			logArgs = []string{app.dockerCompose, "--context", app.dockerContext}
+		}
+		logArgs = append(logArgs, "--project-name", containerId, "logs", "--timestamps", "--no-color")
+		if follow {
+			logArgs = append(logArgs, "--follow")
+		}
+		if app.sinceDateFilterMode {
+			logArgs = append(logArgs, "--since", app.sinceFilterText+"T00:00:00"+app.timezoneFilter)
+		}
+		// Ограничение по времени завершает поток, поэтому не используется в режиме follow
+		if app.untilDateFilterMode && !follow {
+			logArgs = append(logArgs, "--until", app.untilFilterText+"T00:00:00"+app.timezoneFilter)
+		}
+		logArgs = append(logArgs, "--tail", tail)
+	// Podman and Docker case
+	default:
+		logArgs = []string{containerizationSystem}
+		// #38 Добавляем название контекста с проверкой флага для Podman
+		if containerizationSystem == "docker" {
+			logArgs = append(logArgs, "--context", app.dockerContext)
+		} else if containerizationSystem == "podman" && app.podmanContext != "" {
+			logArgs = append(logArgs, "--context", app.podmanContext)
+		}
+		logArgs = append(logArgs, "logs", "--timestamps", "--tail", tail)
+		if follow {
+			logArgs = append(logArgs, "--follow")
+		}
+		// Добавляем фильтрацию по времени
+		if app.sinceDateFilterMode {
+			logArgs = append(logArgs, "--since", app.sinceFilterText+"T00:00:00"+app.timezoneFilter)
+		}
+		if app.untilDateFilterMode && !follow {
+			logArgs = append(logArgs, "--until", app.untilFilterText+"T00:00:00"+app.timezoneFilter)
+		}
+		logArgs = append(logArgs, containerId)
+	}
+	return logArgs
+}
+
+// Функция для получения массива из названия контейнеров в заданном проекте Compose
+func (app *App) getContainersFromCompose(projectName string) []string {
+	var args []string
+	if app.dockerCompose == "docker-compose" {
+		args = []string{app.dockerCompose, "--context", app.dockerContext}
+	} else {
+		args = []string{"docker", "--context", app.dockerContext, "compose"}
+	}
+	args = append(args, "--project-name", projectName, "ps", "-a", "--services")
+	output, err := app.runCommandTimeout(5*time.Second, "Loading the compose stacks", args[0], args[1:]...)
+	if err == nil {
+		containerNameArr := strings.Split(strings.TrimSpace(string(output)), "\n")
+		return containerNameArr
+	} else {
+		return []string{}
+	}
+}
+
+// ---------------------------------------- Log records ----------------------------------------
+
+// Формат исходной строки, из которой получена запись журнала (определяет порядок полей при выводе)
+type recordFormat int
+
+const (
+	plainRecord     recordFormat = iota // строка без распознанной структуры (время может быть в начале строки)
+	syslogRecord                        // формат syslog и journalctl short: время, хост, источник[pid]: сообщение
+	containerRecord                     // журнал контейнера: поток, [источник], время и сообщение
+	delimiterRecord                     // делимитр, отделяющий записи, загруженные после выбора журнала
+)
+
+// Запись журнала, которую заполняют загрузчики (вывод формируется из полей записи)
+type LogRecord struct {
+	format    recordFormat
+	timestamp time.Time         // время записи (нулевое, если не удалось определить)
+	timeText  string            // время в исходном формате
+	source    string            // юнит, процесс, сервис compose или под/контейнер kubectl
+	level     string            // уровень важности
+	stream    string            // поток контейнера stdout/stderr
+	host      string            // имя хоста
+	message   string            // текст сообщения
+	fields    map[string]string // произвольные поля (например, pid)
+	origin    string            // журнал, из которого получена запись при объединении нескольких журналов
+}
+
+// Время в формате syslog/journalctl short (Jan _2 15:04:05 host ident[pid]: message)
+var syslogRecordRegex = regexp.MustCompile(`^([A-Z][a-z]{2} [ \d]\d \d{2}:\d{2}:\d{2}) (\S+) ([^\s\[:]+)(?:\[(\d+)\])?: (.*)$`)
+
+// Время в формате ISO 8601/RFC 3339 в начале строки
+var isoTimeRecordRegex = regexp.MustCompile(`^(\d{4}-\d{2}-\d{2}[T ]\d{2}:\d{2}:\d{2}(?:[.,]\d+)?(?:Z|[+-]\d{2}:?\d{2})?) (.*)$`)
+
+// Заголовок syslog после времени в формате ISO (host ident[pid]: message)
+var syslogHeaderRegex = regexp.MustCompile(`^(\S+) ([^\s\[:]+)(?:\[(\d+)\])?: (.*)$`)
+
+// Уровень важности в тексте сообщения (level=error или ключевое слово в верхнем регистре)
+var levelRecordRegex = regexp.MustCompile(`(?:level=|lvl=|"level":")"?([A-Za-z]+)|\b(TRACE|DEBUG|INFO|NOTICE|WARN|WARNING|ERROR|ERR|CRIT|CRITICAL|ALERT|EMERG|FATAL|PANIC)\b`)
+
+// Названия уровней важности journald по значению поля PRIORITY
+var journalPriorityNames = []string{"emerg", "alert", "crit", "err", "warning", "notice", "info", "debug"}
+
+// Функция для разбора строк журнала в записи
+func parseLogRecords(lines []string) []LogRecord {
+	records := make([]LogRecord, 0, len(lines))
+	for _, line := range lines {
+		records = append(records, parseLogRecord(line))
+	}
+	return records
+}
+
+// Функция для разбора строки журнала в запись (формат syslog, время ISO в начале строки или текст без структуры)
+func parseLogRecord(line string) LogRecord {
+	if match := syslogRecordRegex.FindStringSubmatch(line); match != nil {
+		record := syslogLogRecord(match[1], parseSyslogTime(match[1]), match[2], match[3], match[4], match[5])
+		// Оставляем строку без изменений, если вывод записи не совпадает с исходной строкой
+		if record.text() == line {
+			return record
+		}
+	}
+	if match := isoTimeRecordRegex.FindStringSubmatch(line); match != nil {
+		timestamp := parseISOTime(match[1])
+		if header := syslogHeaderRegex.FindStringSubmatch(match[2]); header != nil {
+			record := syslogLogRecord(match[1], timestamp, header[1], header[2], header[3], header[4])
+			if record.text() == line {
+				return record
+			}
+		}
+		return LogRecord{
+			format:    plainRecord,
+			timestamp: timestamp,
+			timeText:  match[1],
+			level:     parseLevel(match[2]),
+			message:   match[2],
+		}
+	}
+	return LogRecord{format: plainRecord, level: parseLevel(line), message: line}
+}
+
+// Функция для заполнения записи в формате syslog
+func syslogLogRecord(timeText string, timestamp time.Time, host, source, pid, message string) LogRecord {
+	record := LogRecord{
+		format:    syslogRecord,
+		timestamp: timestamp,
+		timeText:  timeText,
+		source:    source,
+		level:     parseLevel(message),
+		host:      host,
+		message:   message,
+	}
+	if pid != "" {
+		record.fields = map[string]string{"pid": pid}
+	}
+	return record
+}
+
+// Функция для разбора времени syslog без года (если дата в будущем, значит запись за прошлый год)
+func parseSyslogTime(timeText string) time.Time {
+	now := time.Now()
+	timestamp, err := time.ParseInLocation("2006 Jan _2 15:04:05", strconv.Itoa(now.Year())+" "+timeText, time.Local)
+	if err != nil {
+		return time.Time{}
+	}
+	if timestamp.After(now.AddDate(0, 0, 1)) {
+		timestamp = timestamp.AddDate(-1, 0, 0)
+	}
+	return timestamp
+}
+
+// Функция для разбора времени в формате ISO 8601 (без часового пояса используется локальное время)
+func parseISOTime(timeText string) time.Time {
+	timeText = strings.Replace(strings.Replace(timeText, " ", "T", 1), ",", ".", 1)
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02T15:04:05.999999999Z0700"} {
+		if timestamp, err := time.Parse(layout, timeText); err == nil {
+			return timestamp
+		}
+	}
+	timestamp, err := time.ParseInLocation("2006-01-02T15:04:05.999999999", timeText, time.Local)
+	if err != nil {
+		return time.Time{}
+	}
+	return timestamp
+}
+
+// Функция для определения уровня важности по тексту сообщения
+func parseLevel(message string) string {
+	match := levelRecordRegex.FindStringSubmatch(message)
+	if match == nil {
+		return ""
+	}
+	level := strings.ToLower(match[1] + match[2])
+	switch level {
+	case "warn":
+		return "warning"
+	case "error":
+		return "err"
+	case "critical", "fatal", "panic":
+		return "crit"
+	case "emergency":
+		return "emerg"
+	}
+	return level
+}
+
+// Функция для разбора строки журнала контейнера в запись (false - время в строке не найдено)
+func parseContainerRecord(line string, containerizationSystem string, isError bool) (LogRecord, bool) {
+	record := LogRecord{format: containerRecord}
+	switch containerizationSystem {
+	case "compose":
+		// Название сервиса в префиксе compose отделяется символом "|"
+		if source, message, found := strings.Cut(line, "|"); found {
+			record.source = strings.TrimSpace(source)
+			line = strings.TrimPrefix(message, " ")
+		}
+	case "kubectl":
+		// Префикс в формате [pod/<podName>/<containerName>] без названия типа объекта
+		if strings.HasPrefix(line, "[") {
+			if source, message, found := strings.Cut(line[1:], "] "); found {
+				record.source = strings.TrimPrefix(source, "pod/")
+				line = message
+			}
+		}
+	default:
+		// Поток известен только для docker и podman (compose и kubectl выводят журнал в один поток)
+		record.stream = "stdout"
+		if isError {
+			record.stream = "stderr"
+		}
+	}
+	timeText, message, _ := strings.Cut(line, " ")
+	timestamp, err := time.Parse(time.RFC3339Nano, timeText)
+	if err != nil {
+		record.message = line
+		record.level = parseLevel(line)
+		return record, false
+	}
+	record.timestamp = timestamp
+	record.timeText = timeText
+	record.message = message
+	record.level = parseLevel(message)
+	return record, true
+}
+
+// Функция для формирования строки записи с учетом режимов вывода контейнеров
+func (app *App) recordLine(r LogRecord) string {
+	return app.recordPrefix(r) + app.recordBody(r)
+}
+
+// Функция для формирования префикса записи с названием объединенного журнала, сервиса compose или пода kubectl
+func (app *App) recordPrefix(r LogRecord) string {
+	if name := r.prefixName(); name != "" {
+		return "[" + name + "] "
+	}
+	return ""
+}
+
+// Функция для получения названия источника записи в префиксе
+func (r LogRecord) prefixName() string {
+	if r.origin != "" {
+		return r.origin
+	}
+	if r.format == containerRecord {
+		return r.source
+	}
+	return ""
+}
+
+// Функция для формирования строки записи без префикса источника
+func (app *App) recordBody(r LogRecord) string {
+	if r.format != containerRecord {
+		return r.text()
+	}
+	var builder strings.Builder
+	if app.streamTypeDocker && r.stream != "" {
+		builder.WriteString(r.stream + " ")
+	}
+	if app.timestampDocker && r.timeText != "" {
+		builder.WriteString(r.timeText + " ")
+	}
+	builder.WriteString(r.message)
+	return builder.String()
+}
+
+// Функция для формирования строки записи в исходном формате
+func (r LogRecord) text() string {
+	switch r.format {
+	case syslogRecord:
+		line := r.timeText + " " + r.host + " " + r.source
+		if pid := r.fields["pid"]; pid != "" {
+			line += "[" + pid + "]"
+		}
+		return line + ": " + r.message
+	case delimiterRecord:
+		return r.message
+	case containerRecord:
+		line := r.message
+		if r.timeText != "" {
+			line = r.timeText + " " + line
+		}
+		if r.source != "" {
+			line = "[" + r.source + "] " + line
+		}
+		return line
+	}
+	if r.timeText != "" {
+		return r.timeText + " " + r.message
+	}
+	return r.message
+}
+
+// Функция для проверки, что запись является делимитром
+func isDelimiterRecord(r LogRecord) bool {
+	return r.format == delimiterRecord
+}
+
+// Функция для формирования строк из записей журнала
+func (app *App) recordLines(records []LogRecord) []string {
+	lines := make([]string, 0, len(records))
+	for _, record := range records {
+		lines = append(lines, app.recordLine(record))
+	}
+	return lines
+}
+
+// Функция для фильтрации записей по времени в режиме фильтрации по дате (записи без времени не фильтруются)
+func (app *App) filterRecordsByDate(records []LogRecord) []LogRecord {
+	if !app.sinceDateFilterMode && !app.untilDateFilterMode {
+		return records
+	}
+	// Границы дат совпадают с аргументами --since и --until команды источника: journalctl получает дату в локальном времени,
+	// а команды журналов контейнеров и подов со смещением UTC из параметра --timezone-filter
+	since, sinceErr := time.ParseInLocation("2006-01-02", app.sinceFilterText, time.Local)
+	until, untilErr := time.ParseInLocation("2006-01-02", app.untilFilterText, time.Local)
+	switch app.currentSource.(type) {
+	case *containerSource, *kubernetesSource:
+		timezone := app.timezoneFilter
+		if timezone == "" {
+			timezone = "+00:00"
+		}
+		since, sinceErr = time.Parse(time.RFC3339, app.sinceFilterText+"T00:00:00"+timezone)
+		until, untilErr = time.Parse(time.RFC3339, app.untilFilterText+"T00:00:00"+timezone)
+	}
+	filteredRecords := make([]LogRecord, 0, len(records))
+	for _, record := range records {
+		if !record.timestamp.IsZero() {
+			if app.sinceDateFilterMode && sinceErr == nil && record.timestamp.Before(since) {
+				continue
+			}
+			if app.untilDateFilterMode && untilErr == nil && !record.timestamp.Before(until) {
+				continue
+			}
+		}
+		filteredRecords = append(filteredRecords, record)
+	}
+	return filteredRecords
+}
+
+// ---------------------------------------- Log sources ----------------------------------------
+
+// Интерфейс источника журналов для окон списков (services/varLogs/docker)
+type LogSource interface {
+	Window() string                                      // название окна со списком журналов источника
+	Active(app *App) bool                                // источник используется для текущего списка в окне
+	List(app *App)                                       // загрузка списка журналов
+	LoadTail(ctx context.Context, app *App, name string) // загрузка последних строк выбранного журнала
+	LoadIncremental(ctx context.Context, app *App)       // обновление вывода последнего выбранного журнала
+	Describe() string                                    // описание выбранного журнала для заголовка окна вывода
+}
+
+// Реестр источников журналов (порядок определяет приоритет выбора источника для окна)
+func (app *App) logSources() []LogSource {
+	return []LogSource{
+		&app.journaldLogs,
+		&app.fileLogs,
+		&app.kubernetesLogs,
+		&app.containerLogs,
+	}
+}
+
+// Функция для получения активного источника журналов для окна
+func (app *App) logSourceFor(window string) LogSource {
+	for _, source := range app.logSources() {
+		if source.Window() == window && source.Active(app) {
+			return source
+		}
+	}
+	return nil
+}
+
+// Функция для загрузки журнала с отменой предыдущей незавершенной загрузки
+// Состоянием приложения и окнами владеет только основной поток интерфейса: в fastMode журнал загружается в горутине на копии приложения,
+// а результат применяется через gui.Update (параметр load получает приложение, в котором нужно выполнить загрузку)
+func (app *App) runLoad(source LogSource, load func(ctx context.Context, app *App)) {
+	// Завершаем процесс предыдущей загрузки, ее результат не будет выведен
+	app.cancelLoad()
+	if app.loadApply != nil {
+		app.waitLoad()
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	app.loadCancel = cancel
+	if !app.fastMode {
+		load(ctx, app)
+		app.startFollow(source)
+		return
+	}
+	worker := app.loadWorker()
+	done := make(chan struct{})
+	app.loadDone = done
+	// Без интерфейса результат применяется в вызывающем потоке после ожидания завершения загрузки (waitLoad)
+	if app.testMode {
+		app.loadApply = func() {
+			app.applyLoad(ctx, worker, source)
+		}
+	}
+	go func() {
+		defer close(done)
+		load(ctx, worker)
+		if app.testMode {
+			return
+		}
+		app.gui.Update(func(g *gocui.Gui) error {
+			app.applyLoad(ctx, worker, source)
+			return nil
+		})
+	}()
+}
+
+// Функция для отмены текущей загрузки журнала
+func (app *App) cancelLoad() {
+	if app.loadCancel != nil {
+		app.loadCancel()
+		app.loadCancel = nil
+	}
+}
+
+// Функция для ожидания завершения загрузки журнала в горутине и применения ее результата без интерфейса (testMode)
+func (app *App) waitLoad() {
+	if app.loadDone == nil {
+		return
+	}
+	<-app.loadDone
+	if apply := app.loadApply; apply != nil {
+		app.loadApply = nil
+		apply()
+	}
+}
+
+// Функция для проверки, что загрузка журнала в горутине еще выполняется
+func (app *App) loadInProgress() bool {
+	if app.loadDone == nil {
+		return false
+	}
+	select {
+	case <-app.loadDone:
+		return false
+	default:
+		return true
+	}
+}
+
+// Состояние приложения, которое загрузка журнала в копии приложения изменяет и возвращает в основной поток целиком (applyLoad).
+// Поля, которые изменяет загрузка журнала, добавляются сюда, иначе их изменения в горутине будут потеряны
+type loadState struct {
+	// Состояние источников журналов для автообновления вывода при смене окна
+	journaldLogs   journaldSource
+	fileLogs       fileSource
+	containerLogs  containerSource
+	kubernetesLogs kubernetesSource
+
+	lastContainerizationSystem string // система контейнеризации последнего вывода (для покраски префиксов compose)
+
+	lastDateUpdateFile time.Time // последняя дата изменения файла
+	lastSizeFile       int64     // размер файла
+	updateFile         bool      // проверка для обновления вывода в горутине (отключение только если нет изменений в файле и для Windows Event)
+
+	dockerStreamLogs       bool   // принудительное чтение журналов контейнеров Docker из потоков (по умолчанию, чтение происходит из файловой системы, если есть доступ)
+	dockerStreamLogsStatus string // отображаемый режим чтения журнала Docker в статусе (в зависимости от прав доступа и флага)
+
+	uniquePrefixColorMap map[string]string // карта для хранения уникального цвета для каждого контейнера в стеках compose
+
+	debugStartTime time.Time // время начала последней загрузки журнала
+}
+
+// Функция для создания копии приложения, в которой горутина загружает журнал без обращения к состоянию основного потока
+func (app *App) loadWorker() *App {
+	worker := *app
+	worker.background = true
+	worker.pending = nil
+	worker.loadApply = nil
+	// Копируем записи, списки и карту, которые основной поток может изменить во время загрузки
+	// (добавление к записям в горутине не должно изменять массив, который читает основной поток)
+	worker.currentLogRecords = slices.Clone(app.currentLogRecords)
+	worker.filteredLogRecords = slices.Clone(app.filteredLogRecords)
+	worker.journals = slices.Clone(app.journals)
+	worker.logfiles = slices.Clone(app.logfiles)
+	worker.dockerContainers = slices.Clone(app.dockerContainers)
+	worker.uniquePrefixColorMap = maps.Clone(app.uniquePrefixColorMap)
+	return &worker
+}
+
+// Функция для получения источника журналов того же типа из состояния приложения (для копии приложения)
+func (app *App) sourceOf(source LogSource) LogSource {
+	switch source.(type) {
+	case *journaldSource:
+		return &app.journaldLogs
+	case *fileSource:
+		return &app.fileLogs
+	case *containerSource:
+		return &app.containerLogs
+	case *kubernetesSource:
+		return &app.kubernetesLogs
+	}
+	return source
+}
+
+// Функция для применения результата загрузки журнала из горутины (выполняется в основном потоке интерфейса)
+func (app *App) applyLoad(ctx context.Context, worker *App, source LogSource) {
+	// Пропускаем результат загрузки, отмененной выбором другого журнала (открытый в ней файл закрывается)
+	if ctx.Err() != nil {
+		if worker.fileLogs.tail != app.fileLogs.tail {
+			worker.fileLogs.closeTail()
+		}
+		return
+	}
+	// Файл, замененный в загрузке, закрывается
+	if app.fileLogs.tail != worker.fileLogs.tail {
+		app.fileLogs.closeTail()
+	}
+	// Состояние источников и отслеживание изменений для автообновления
+	app.loadState = worker.loadState
+	app.applyPending(worker)
+	app.startFollow(source)
+}
+
+// Функция для выполнения отложенных действий с интерфейсом из горутины (выполняется в основном потоке интерфейса)
+func (app *App) applyPending(worker *App) {
+	for _, action := range worker.pending {
+		action(app)
+	}
+}
+
+// Функция для изменения окон интерфейса (в копии приложения действие откладывается до применения результата в основном потоке)
+func (app *App) uiUpdate(action func(app *App)) {
+	if app.testMode {
+		return
+	}
+	if app.background {
+		app.pending = append(app.pending, action)
+		return
+	}
+	action(app)
+}
+
+// Функция для обновления заголовка окна вывода с названием источника журнала
+func (app *App) setLogSubtitle(text string) {
+	app.uiUpdate(func(app *App) {
+		v, err := app.gui.View("logs")
+		if err == nil {
+			v.Subtitle = "[ " + text + " ]"
+		}
+	})
+}
+
+// Функция для вывода ошибки загрузки журнала в окне вывода (без параметров окно только очищается)
+func (app *App) showLogError(a ...any) {
+	app.uiUpdate(func(app *App) {
+		v, _ := app.gui.View("logs")
+		v.Clear()
+		if len(a) > 0 {
+			fmt.Fprintln(v, a...)
+		}
+	})
+}
+
+// Функция для вывода загруженных строк журнала с применением текущего фильтра
+func (app *App) showLogLines(newUpdate bool) {
+	if app.background {
+		records := app.currentLogRecords
+		app.pending = append(app.pending, func(app *App) {
+			app.currentLogRecords = records
+			app.showLogLines(newUpdate)
+		})
+		return
+	}
+	if !app.testMode {
+		app.updateDelimiter(newUpdate)
+		// Применяем текущий фильтр к записям для обновления вывода
+		app.applyFilter(false)
+	}
+}
+
+// Функция для выбора журнала из окна списка и загрузки его вывода через источник
+func (app *App) selectLogSource(window string, name string) {
+	source := app.logSourceFor(window)
+	if source == nil {
+		return
+	}
+	app.stopFollow()
+	// Открытый локальный файл не нужен при выборе журнала из другого источника
+	if _, ok := source.(*fileSource); !ok {
+		app.cancelLoad()
+		app.fileLogs.closeTail()
+	}
+	// Фиксируем для ручного или автоматического обновления вывода журнала
+	app.currentSource = source
+	app.lastSelected = name
+	app.runLoad(source, func(ctx context.Context, app *App) {
+		app.sourceOf(source).LoadTail(ctx, app, name)
+	})
+}
+
+// Функция для повторной загрузки (newUpdate) или обновления вывода последнего выбранного журнала
+func (app *App) loadCurrentSource(newUpdate bool) {
+	source := app.currentSource
+	if source == nil {
+		return
+	}
+	app.stopFollow()
+	if newUpdate {
+		name := app.lastSelected
+		app.runLoad(source, func(ctx context.Context, app *App) {
+			app.sourceOf(source).LoadTail(ctx, app, name)
+		})
+	} else {
+		app.runLoad(source, func(ctx context.Context, app *App) {
+			app.sourceOf(source).LoadIncremental(ctx, app)
+		})
+	}
+}
+
+// Функция для обновления всех текущих списков журналов
+func (app *App) updateLists() {
+	for _, source := range app.logSources() {
+		if source.Active(app) {
+			source.List(app)
+		}
+	}
+}
+
+// Источник журналов systemd/journald, auditd и событий Windows
+type journaldSource struct {
+	units      string   // список, из которого выбран журнал (systemUnits/userUnits/systemJournals/kernelBoot/auditd/coredumps)
+	name       string   // название выбранного журнала
+	marked     []string // отмеченные журналы для объединенного вывода
+	bootId     string   // id загрузки для kernelBoot, поле и значение событий для auditd или условия дампа для coredumps
+	cursor     string   // курсор последней загруженной записи (__CURSOR) или последнего события аудита
+	cursorArgs string   // аргументы journalctl, с которыми получен курсор
+	grep       string   // аргументы поиска journalctl --grep, с которыми загружен журнал
+}
+
+func (s *journaldSource) Window() string { return "services" }
+
+func (s *journaldSource) Active(app *App) bool { return true }
+
+func (s *journaldSource) List(app *App) {
+	if app.getOS == "windows" {
+		app.loadWinEvents()
+	} else {
+		app.loadSelectedServices()
+	}
+}
+
+func (s *journaldSource) LoadTail(ctx context.Context, app *App, name string) {
+	app.loadJournalLogs(ctx, name, true)
+}
+
+func (s *journaldSource) LoadIncremental(ctx context.Context, app *App) {
+	app.loadJournalLogs(ctx, s.name, false)
+}
+
+func (s *journaldSource) Describe() string {
+	if s.units == "kernelBoot" {
+		if len(s.marked) == 2 {
+			return s.units + "/" + strings.Join(s.marked, " <> ")
+		}
+		return s.units + "/" + s.name
+	}
+	if len(s.marked) > 0 {
+		return s.units + "/" + strings.Join(s.marked, "+")
+	}
+	return s.units + "/" + removeStatusPrefix(s.name)
+}
+
+// Источник журналов из файловой системы
+type fileSource struct {
+	path    string      // полный путь к выбранному файлу
+	family  []string    // еще не загруженные ротированные файлы семейства от старых к новым
+	history []LogRecord // записи загруженных ротированных файлов, которые выводятся перед текущим файлом
+	tail    *fileTail   // открытый локальный файл для чтения только дописанных строк при обновлении
+}
+
+func (s *fileSource) Window() string { return "varLogs" }
+
+func (s *fileSource) Active(app *App) bool { return true }
+
+func (s *fileSource) List(app *App) {
+	if app.getOS == "windows" {
+		app.loadWinFiles(app.selectPath)
+	} else {
+		app.loadFiles(app.selectPath)
+	}
+}
+
+func (s *fileSource) LoadTail(ctx context.Context, app *App, name string) {
+	app.loadFileLogs(ctx, name, true)
+}
+
+func (s *fileSource) LoadIncremental(ctx context.Context, app *App) {
+	app.loadFileLogs(ctx, s.path, false)
+}
+
+func (s *fileSource) Describe() string {
+	if len(s.family) > 0 {
+		return s.path + " (+" + strconv.Itoa(len(s.family)) + " rotated)"
+	}
+	return s.path
+}
+
+// Источник журналов контейнеров Docker, Podman и стеков Compose
+type containerSource struct {
+	system string // система контейнеризации выбранного контейнера (docker/compose/podman/kubernetes)
+	name   string // название выбранного контейнера
+	id     string // id контейнера (или название стека для compose)
+}
+
+func (s *containerSource) Window() string { return "docker" }
+
+func (s *containerSource) Active(app *App) bool {
+	return app.selectContainerizationSystem != "kubernetes"
+}
+
+func (s *containerSource) List(app *App) {
+	app.loadDockerContainer(app.selectContainerizationSystem)
+}
+
+func (s *containerSource) LoadTail(ctx context.Context, app *App, name string) {
+	app.loadDockerLogs(ctx, name, true)
+}
+
+func (s *containerSource) LoadIncremental(ctx context.Context, app *App) {
+	app.loadDockerLogs(ctx, s.name, false)
+}
+
+func (s *containerSource) Describe() string {
+	return s.system + "/" + removeStatusPrefix(s.name)
+}
+
+// Источник журналов подов Kubernetes
+type kubernetesSource struct {
+	pod       string // название выбранного пода
+	namespace string // namespace выбранного пода
+}
+
+func (s *kubernetesSource) Window() string { return "docker" }
+
+func (s *kubernetesSource) Active(app *App) bool {
+	return app.selectContainerizationSystem == "kubernetes"
+}
+
+func (s *kubernetesSource) List(app *App) {
+	app.loadDockerContainer(app.selectContainerizationSystem)
+}
+
+func (s *kubernetesSource) LoadTail(ctx context.Context, app *App, name string) {
+	app.loadDockerLogs(ctx, name, true)
+}
+
+func (s *kubernetesSource) LoadIncremental(ctx context.Context, app *App) {
+	app.loadDockerLogs(ctx, s.pod, false)
+}
+
+func (s *kubernetesSource) Describe() string {
+	return "kubernetes/" + s.namespace + "/" + removeStatusPrefix(s.pod)
+}
+
+// Функция для удаления статуса ([state] name) из названия журнала или контейнера
+func removeStatusPrefix(name string) string {
+	parts := strings.Split(name, "] ")
+	if len(parts) == 2 && len(parts[1]) > 0 {
+		return parts[1]
+	}
+	return name
+}
+
+// Интерфейс источника, который поддерживает чтение новых строк журнала из постоянного потока (режим follow)
+type FollowSource interface {
+	LogSource
+	// Чтение новых строк до отмены контекста или завершения потока
+	Follow(ctx context.Context, app *App, emit func(record LogRecord)) error
+}
+
+// Ошибка для журналов, которые не поддерживают режим follow (вывод обновляется по таймеру)
+var ErrFollowNotSupported = errors.New("follow mode is not supported for this log")
+
+// Активный поток чтения новых строк журнала
+type logFollower struct {
+	cancel context.CancelFunc
+}
+
+// Накопление новых записей между обновлениями интерфейса
+type recordBatcher struct {
+	mu      sync.Mutex
+	records []LogRecord
+}
+
+func (b *recordBatcher) add(record LogRecord) {
+	b.mu.Lock()
+	b.records = append(b.records, record)
+	b.mu.Unlock()
+}
+
+func (b *recordBatcher) take() []LogRecord {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	records := b.records
+	b.records = nil
+	return records
+}
+
+// Интервал вывода накопленных строк в режиме follow
+const followFlushInterval = 200 * time.Millisecond
+
+// Функция для остановки текущего потока чтения новых строк
+func (app *App) stopFollow() {
+	if app.follower != nil {
+		app.follower.cancel()
+		app.follower = nil
+	}
+}
+
+// Функция для запуска потока чтения новых строк после загрузки журнала (вызывается в основном потоке интерфейса)
+func (app *App) startFollow(source LogSource) {
+	if !app.followMode || app.testMode {
+		return
+	}
+	app.stopFollow()
+	// Поток читает параметры источника из копии приложения
+	worker := app.loadWorker()
+	followSource, ok := worker.sourceOf(source).(FollowSource)
+	if !ok {
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	follower := &logFollower{cancel: cancel}
+	app.follower = follower
+	go app.runFollow(ctx, follower, worker, followSource)
+}
+
+// Функция для чтения новых строк из источника с выводом накопленных строк в интерфейс
+func (app *App) runFollow(ctx context.Context, follower *logFollower, worker *App, source FollowSource) {
+	batcher := &recordBatcher{}
+	// Передаем накопленные записи в интерфейс, только если поток не был остановлен или заменен
+	flush := func() {
+		records := batcher.take()
+		if len(records) == 0 {
+			return
+		}
+		app.gui.Update(func(g *gocui.Gui) error {
+			if app.follower == follower {
+				app.appendLogRecords(records)
 			}
-			// Не добавляем префексы названия потока в отключенном режиме для Docker (а также для compose и kubectl по умолчанию)
-			if !app.streamTypeDocker || containerizationSystem == "compose" || containerizationSystem == "kubectl" {
-				finalLines = append(finalLines, entryLine)
-			} else {
-				prefix := "stdout "
-				if entry.isError {
-					prefix = "stderr "
+			return nil
+		})
+	}
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(followFlushInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				flush()
+			case <-done:
+				flush()
+				return
+			}
+		}
+	}()
+	err := source.Follow(ctx, worker, batcher.add)
+	close(done)
+	wg.Wait()
+	if err != nil && ctx.Err() == nil && !errors.Is(err, ErrFollowNotSupported) && worker.logging {
+		slog.Error("Follow mode stopped", "source", source.Describe(), "error", err)
+	}
+	// Освобождаем поток, чтобы вывод снова обновлялся по таймеру
+	app.gui.Update(func(g *gocui.Gui) error {
+		if app.follower == follower {
+			app.follower = nil
+		}
+		return nil
+	})
+}
+
+// Функция для добавления новых записей в конец вывода (фильтрация и покраска применяется только к новым записям)
+func (app *App) appendLogRecords(records []LogRecord) {
+	if len(records) == 0 {
+		return
+	}
+	count, err := strconv.Atoi(app.logViewCount)
+	if err != nil {
+		count = len(app.currentLogRecords) + len(records)
+	}
+	// Загруженные ротированные файлы семейства выводятся перед текущим файлом и не удаляются ограничением режима tail
+	if _, ok := app.currentSource.(*fileSource); ok {
+		count += len(app.fileLogs.history)
+	}
+	// Добавляем записи перед завершающей пустой записью и ограничиваем размер журнала режимом tail
+	currentRecords := trimEmptyRecords(app.currentLogRecords)
+	currentRecords = append(currentRecords, records...)
+	if len(currentRecords) > count {
+		currentRecords = currentRecords[len(currentRecords)-count:]
+	}
+	app.currentLogRecords = append(currentRecords, LogRecord{})
+	// Сохраняем курсор последней записи journald, чтобы после отключения режима follow читать только новые записи
+	for i := len(records) - 1; i >= 0 && app.journaldLogs.cursor != ""; i-- {
+		if cursor := records[i].fields["__CURSOR"]; cursor != "" {
+			app.journaldLogs.cursor = cursor
+			break
+		}
+	}
+	filteredRecords, filteredLines, err := app.filterRecords(app.filterRecordsByDate(records))
+	if err != nil || len(filteredLines) == 0 {
+		return
+	}
+	filteredLines = trimEmptyLines(app.colorRecords(filteredRecords, filteredLines))
+	filteredRecords = filteredRecords[:min(len(filteredLines), len(filteredRecords))]
+	outputLines := trimEmptyLines(app.filteredLogLines)
+	outputRecords := slices.Clone(app.filteredLogRecords[:min(len(outputLines), len(app.filteredLogRecords))])
+	outputLines = append(outputLines, filteredLines...)
+	outputRecords = append(outputRecords, filteredRecords...)
+	if len(outputLines) > count {
+		// Сохраняем позицию просмотра при ручной прокрутке
+		app.logScrollPos = max(app.logScrollPos-(len(outputLines)-count), 0)
+		outputRecords = outputRecords[min(len(outputLines)-count, len(outputRecords)):]
+		outputLines = outputLines[len(outputLines)-count:]
+	}
+	app.filteredLogLines = append(outputLines, "", "")
+	app.filteredLogRecords = append(outputRecords, LogRecord{}, LogRecord{})
+	if !app.testMode {
+		app.updateLogsView(app.autoScroll)
+	}
+}
+
+// Функция для удаления завершающих пустых записей
+func trimEmptyRecords(records []LogRecord) []LogRecord {
+	for len(records) > 0 && records[len(records)-1].text() == "" {
+		records = records[:len(records)-1]
+	}
+	return records
+}
+
+// Функция для удаления завершающих пустых строк
+func trimEmptyLines(lines []string) []string {
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// Функция для построчного чтения потоков запущенной команды в режиме follow (isError - строка из stderr)
+func followProcess(proc *Process, emit func(line string, isError bool)) error {
+	scan := func(r io.Reader, isError bool) {
+		scanner := bufio.NewScanner(r)
+		scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+		for scanner.Scan() {
+			emit(strings.TrimSuffix(scanner.Text(), "\r"), isError)
+		}
+		// Вычитываем остаток потока, чтобы не блокировать процесс при слишком длинной строке
+		_, _ = io.Copy(io.Discard, r)
+	}
+	var wg sync.WaitGroup
+	if proc.Stderr != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			scan(proc.Stderr, true)
+		}()
+	}
+	scan(proc.Stdout, false)
+	wg.Wait()
+	return proc.Wait()
+}
+
+func (s *journaldSource) Follow(ctx context.Context, app *App, emit func(record LogRecord)) error {
+	var args []string
+	switch {
+	case app.getOS == "windows" || s.units == "auditd" || s.units == "coredumps":
+		return ErrFollowNotSupported
+	case s.units == "kernelBoot" && len(s.marked) == 2:
+		return ErrFollowNotSupported
+	case s.units == "kernelBoot":
+		args = append(app.journalSourceArgs(), "-k", "-b", s.bootId)
+		args = append(args, app.journalGrepArgs()...)
+	case len(s.marked) > 0:
+		args = app.journalFilterArgs(s.units, s.marked...)
+	default:
+		args = app.journalFilterArgs(s.units, removeStatusPrefix(s.name))
+	}
+	// Продолжаем чтение после последней загруженной записи, чтобы не потерять записи между загрузкой и запуском потока
+	if s.cursor != "" {
+		args = append(args, "--after-cursor="+s.cursor)
+	} else {
+		args = append(args, "--lines=0")
+	}
+	args = append(args, "--follow", "--output=json", "--no-pager")
+	proc, err := app.cmdExecutor().Start(ctx, Command{
+		Name:   "journalctl",
+		Args:   args,
+		Action: "Following logs from journald",
+	})
+	if err != nil {
+		return err
+	}
+	return followProcess(proc, func(line string, isError bool) {
+		records := journalRecords(line)
+		if len(s.marked) > 1 {
+			app.setRecordOrigins(records, s.units, s.marked)
+		}
+		for _, record := range records {
+			emit(record)
+		}
+	})
+}
+
+// Функция для преобразования записи journalctl в формате JSON в записи журнала (вывод как в формате short при загрузке журнала)
+func journalRecords(line string) []LogRecord {
+	var entry map[string]any
+	if err := json.Unmarshal([]byte(line), &entry); err != nil {
+		return nil
+	}
+	field := func(name string) string {
+		switch value := entry[name].(type) {
+		case string:
+			return value
+		// Поля с непечатаемыми символами передаются массивом байт
+		case []any:
+			data := make([]byte, 0, len(value))
+			for _, b := range value {
+				if n, ok := b.(float64); ok {
+					data = append(data, byte(n))
 				}
-				finalLine := prefix + entryLine
-				finalLines = append(finalLines, finalLine)
 			}
+			return string(data)
+		}
+		return ""
+	}
+	record := LogRecord{format: syslogRecord, host: field("_HOSTNAME")}
+	if usec, err := strconv.ParseInt(field("__REALTIME_TIMESTAMP"), 10, 64); err == nil {
+		record.timestamp = time.UnixMicro(usec)
+		record.timeText = record.timestamp.Format("Jan 02 15:04:05")
+	}
+	record.source = field("SYSLOG_IDENTIFIER")
+	if record.source == "" {
+		record.source = field("_COMM")
+	}
+	if priority, err := strconv.Atoi(field("PRIORITY")); err == nil && priority >= 0 && priority < len(journalPriorityNames) {
+		record.level = journalPriorityNames[priority]
+	}
+	// Сохраняем все текстовые поля записи
+	record.fields = make(map[string]string, len(entry))
+	for name := range entry {
+		if value := field(name); value != "" {
+			record.fields[name] = value
+		}
+	}
+	pid := field("SYSLOG_PID")
+	if pid == "" {
+		pid = field("_PID")
+	}
+	record.fields["pid"] = pid
+	// Многострочные сообщения выводятся с отступом, как в формате short
+	messageLines := strings.Split(strings.TrimSuffix(field("MESSAGE"), "\n"), "\n")
+	record.message = messageLines[0]
+	indent := strings.Repeat(" ", len(strings.TrimSuffix(record.text(), record.message)))
+	records := make([]LogRecord, 0, len(messageLines))
+	records = append(records, record)
+	for _, messageLine := range messageLines[1:] {
+		records = append(records, LogRecord{
+			format:    plainRecord,
+			timestamp: record.timestamp,
+			level:     record.level,
+			message:   indent + messageLine,
+		})
+	}
+	return records
+}
+
+func (s *fileSource) Follow(ctx context.Context, app *App, emit func(record LogRecord)) error {
+	path := s.path
+	if app.getOS == "windows" || !followableFile(path) {
+		return ErrFollowNotSupported
+	}
+	// В режиме ssh файл читается на удаленной системе через tail
+	if app.sshMode {
+		proc, err := app.cmdExecutor().Start(ctx, Command{
+			Name:   "tail",
+			Args:   []string{"-n", "0", "-F", path},
+			Action: "Following log file",
+		})
+		if err != nil {
+			return err
+		}
+		return followProcess(proc, func(line string, isError bool) {
+			emit(parseLogRecord(line))
+		})
+	}
+	// Продолжаем чтение с позиции файла, открытого при загрузке, чтобы не потерять строки между загрузкой и запуском потока
+	tail := s.tail
+	if tail == nil {
+		var err error
+		if tail, err = openFileTail(path); err != nil {
+			return err
+		}
+		defer tail.Close()
+	}
+	return followFile(ctx, tail, emit)
+}
+
+// Функция для проверки, что файл является текстовым журналом (бинарные журналы и архивы читаются только по таймеру)
+func followableFile(path string) bool {
+	for _, suffix := range []string{"asl", "pcap", "pcapng", "pflog", ".gz", ".xz", ".bz2", ".zst", "lastlog", "lastlogin"} {
+		if strings.HasSuffix(path, suffix) {
+			return false
+		}
+	}
+	for _, name := range []string{"wtmp", "utmp", "utx.log", "btmp"} {
+		if strings.Contains(path, name) {
+			return false
+		}
+	}
+	return true
+}
+
+// Интервал проверки новых данных в файле в режиме follow
+const followFileInterval = 250 * time.Millisecond
+
+// Функция для чтения новых строк, дописанных в локальный файл после позиции открытого файла (в процессе без запуска tail)
+func followFile(ctx context.Context, tail *fileTail, emit func(record LogRecord)) error {
+	path := tail.path
+	ticker := time.NewTicker(followFileInterval)
+	defer ticker.Stop()
+	for {
+		err := tail.read(func(line string) {
+			emit(parseLogRecord(line))
+		}, func(change string) {
+			emit(logSeparator(path + " " + change))
+		})
+		// Файл закрывается основным потоком после остановки чтения при выборе другого журнала
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
 		}
-		app.currentLogLines = finalLines
 	}
-	// Обновляем фильтр и делиметр всегда для потоков ИЛИ если есть изменения в файле при его чтение
-	if !readFileContainer || (readFileContainer && app.updateFile) || containerizationSystem != "docker" {
-		app.updateDelimiter(newUpdate)
-		app.applyFilter(false)
+}
+
+// Изменения локального файла, которые отмечаются в выводе
+const (
+	fileRotated   = "rotated"   // файл заменен новым (новый inode)
+	fileTruncated = "truncated" // файл усечен (copytruncate)
+)
+
+// Открытый локальный файл журнала с позицией последнего чтения
+type fileTail struct {
+	mu      sync.Mutex
+	path    string
+	file    *os.File
+	info    os.FileInfo
+	offset  int64
+	partial string // последняя неполная строка до записи символа переноса
+}
+
+// Функция для открытия локального файла с позицией чтения в конце файла
+func openFileTail(path string) (*fileTail, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	return &fileTail{path: path, file: file, info: info, offset: info.Size()}, nil
+}
+
+// Функция для чтения строк, дописанных с последней позиции (mark вызывается при усечении или замене файла)
+func (t *fileTail) read(emit func(line string), mark func(change string)) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.file == nil {
+		return os.ErrClosed
+	}
+	info, err := t.file.Stat()
+	if err != nil {
+		return err
+	}
+	// Размер меньше прочитанного означает усечение файла, читаем его с начала
+	if info.Size() < t.offset {
+		t.offset = 0
+		t.partial = ""
+		mark(fileTruncated)
+	}
+	if err := t.readAppended(emit); err != nil {
+		return err
+	}
+	// Проверяем, что по пути находится тот же файл (при ротации старый файл переименовывается и создается новый)
+	current, err := os.Stat(t.path)
+	if err != nil || os.SameFile(current, t.info) {
+		// Новый файл еще не создан, продолжаем читать старый
+		return nil
+	}
+	file, err := os.Open(t.path)
+	if err != nil {
+		return nil
+	}
+	info, err = file.Stat()
+	if err != nil {
+		file.Close()
+		return nil
 	}
+	// Старый файл дочитан, выводим его последнюю неполную строку и переходим к новому файлу
+	if t.partial != "" {
+		emit(strings.TrimSuffix(t.partial, "\r"))
+		t.partial = ""
+	}
+	t.file.Close()
+	t.file, t.info, t.offset = file, info, 0
+	mark(fileRotated)
+	return t.readAppended(emit)
 }
 
-// Функция для получения массива из названия контейнеров в заданном проекте Compose
-func (app *App) getContainersFromCompose(projectName string) []string {
-	var cmd *exec.Cmd
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
-	if app.sshMode {
-		if app.dockerCompose == "docker-compose" {
-			cmd = exec.CommandContext(
-				ctx,
-				"ssh", append(app.sshOptions,
-					app.dockerCompose,
-					"--context", app.dockerContext, "--project-name", projectName, "ps", "-a", "--services",
-				)...)
-		} else {
-			cmd = exec.CommandContext(
-				ctx,
-				"ssh", append(app.sshOptions,
-					"docker",
-					"--context", app.dockerContext, "compose", "--project-name", projectName, "ps", "-a", "--services",
-				)...)
+// Функция для чтения полных строк от последней позиции до конца файла
+func (t *fileTail) readAppended(emit func(line string)) error {
+	if _, err := t.file.Seek(t.offset, io.SeekStart); err != nil {
+		return err
+	}
+	reader := bufio.NewReader(t.file)
+	for {
+		chunk, err := reader.ReadString('\n')
+		t.offset += int64(len(chunk))
+		if err == nil {
+			emit(strings.TrimSuffix(strings.TrimSuffix(t.partial+chunk, "\n"), "\r"))
+			t.partial = ""
+			continue
 		}
-	} else {
-		if app.dockerCompose == "docker-compose" {
-			cmd = exec.CommandContext(
-				ctx,
-				app.dockerCompose,
-				"--context", app.dockerContext, "--project-name", projectName, "ps", "-a", "--services",
-			)
-		} else {
-			cmd = exec.CommandContext(
-				ctx,
-				"docker",
-				"--context", app.dockerContext, "compose", "--project-name", projectName, "ps", "-a", "--services",
-			)
+		t.partial += chunk
+		if err == io.EOF {
+			return nil
 		}
+		return err
 	}
-	cmd.WaitDelay = 2 * time.Second
-	if app.logging {
-		slog.Info(cmd.String(), "action", "Loading the compose stacks")
+}
+
+// Функция для чтения последних строк открытого файла до текущей позиции чтения
+func (t *fileTail) lastLines(logViewCount string) ([]string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.file == nil {
+		return nil, os.ErrClosed
 	}
-	output, err := cmd.Output()
-	if err == nil {
-		containerNameArr := strings.Split(strings.TrimSpace(string(output)), "\n")
-		return containerNameArr
-	} else {
-		return []string{}
+	count, _ := strconv.Atoi(logViewCount)
+	return readLastLinesAt(t.file, t.offset, count)
+}
+
+func (t *fileTail) Close() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.file == nil {
+		return nil
+	}
+	err := t.file.Close()
+	t.file = nil
+	return err
+}
+
+// Функция для закрытия открытого локального файла выбранного журнала
+func (s *fileSource) closeTail() {
+	if s.tail != nil {
+		s.tail.Close()
+		s.tail = nil
 	}
 }
 
-// Функция извлечения timestamp для сортировки
-func parseTimestamp(line string) (time.Time, error) {
-	// Делим строку на две части по первому пробелу
-	parts := strings.SplitN(line, " ", 2)
-	// Удаляем лишние пробелы
-	tsStr := strings.TrimSpace(parts[0])
-	// Парсим строку (извлекаем временную метку)
-	return time.Parse(time.RFC3339Nano, tsStr)
+// Функция для освобождения открытого файла перед загрузкой нового (в копии приложения файл основного потока закрывается в applyLoad)
+func (app *App) releaseFileTail() {
+	if app.background {
+		app.fileLogs.tail = nil
+		return
+	}
+	app.fileLogs.closeTail()
 }
 
-// Функция для удаления timestamp из строки
-func removeTimestamp(line string, containerizationSystem string) string {
-	// Удаляем слово до первого пробела для Docker или Podman
-	if containerizationSystem == "docker" || containerizationSystem == "podman" {
-		// Находим индекс первого пробела
-		spaceIndex := strings.Index(line, " ")
-		// Если пробела нет, возвращаем строку как есть
-		if spaceIndex == -1 {
-			return line
-		}
-		// Возвращаем строку начиная с символа после первого пробела
-		return line[spaceIndex+1:]
-	} else {
-		// Удаляем второй индекс для compose или kubectl
-		lineArr := strings.Split(line, " ")
-		if len(lineArr) < 3 {
-			return line
-		} else {
-			// Собираем массив в строку без второго индекса
-			linePrefix := lineArr[0]
-			lineText := strings.Join(lineArr[2:], " ")
-			return linePrefix + " " + lineText
-		}
+// Функция для добавления в вывод строк, дописанных в открытый локальный файл с прошлого обновления
+func (app *App) readFileTail() error {
+	var records []LogRecord
+	path := app.fileLogs.path
+	err := app.fileLogs.tail.read(func(line string) {
+		records = append(records, parseLogRecord(line))
+	}, func(change string) {
+		records = append(records, logSeparator(path+" "+change))
+	})
+	if err != nil || len(records) == 0 {
+		return err
+	}
+	if app.background {
+		app.pending = append(app.pending, func(app *App) {
+			app.appendLogRecords(records)
+		})
+		return nil
+	}
+	app.appendLogRecords(records)
+	return nil
+}
+
+func (s *containerSource) Follow(ctx context.Context, app *App, emit func(record LogRecord)) error {
+	containerizationSystem := s.system
+	containerId := s.id
+	// Для compose используется название стека без статуса
+	if containerizationSystem == "compose" {
+		containerId = removeStatusPrefix(s.name)
+	}
+	logArgs := app.containerLogArgs(containerizationSystem, containerId, "", "0", true)
+	return app.followContainer(ctx, logArgs, containerizationSystem, emit)
+}
+
+func (s *kubernetesSource) Follow(ctx context.Context, app *App, emit func(record LogRecord)) error {
+	logArgs := app.containerLogArgs("kubectl", removeStatusPrefix(s.pod), s.namespace, "0", true)
+	return app.followContainer(ctx, logArgs, "kubectl", emit)
+}
+
+// Функция для чтения новых строк журнала контейнера из потока с учетом режима вывода потоков
+func (app *App) followContainer(ctx context.Context, logArgs []string, containerizationSystem string, emit func(record LogRecord)) error {
+	streamMode := app.dockerStreamMode
+	// compose и kubectl выводят журнал в один поток
+	if containerizationSystem == "compose" || containerizationSystem == "kubectl" {
+		streamMode = "stdout"
+	}
+	proc, err := app.cmdExecutor().Start(ctx, Command{
+		Name:   logArgs[0],
+		Args:   logArgs[1:],
+		Action: "Following container logs",
+		Stderr: streamMode != "stdout",
+	})
+	if err != nil {
+		return err
 	}
+	return followProcess(proc, func(line string, isError bool) {
+		// Пропускаем пустые строки и строки из отключенного потока
+		if strings.TrimSpace(line) == "" || (streamMode == "stderr" && !isError) {
+			return
+		}
+		record, _ := parseContainerRecord(line, containerizationSystem, isError)
+		emit(record)
+	})
 }
 
 // ---------------------------------------- Filter ----------------------------------------
@@ -5690,6 +8857,8 @@ func (app *App) createFilterEditor(window string) gocui.Editor {
 			app.filterText = strings.TrimSpace(v.Buffer())
 			// Применяем функцию фильтрации к выводу записей журнала
 			app.applyFilter(true)
+			// Выражение в режиме regex передается в journalctl для поиска по всему журналу
+			app.scheduleJournalGrep()
 		case "lists":
 			app.filterListText = strings.TrimSpace(v.Buffer())
 			app.applyFilterList()
@@ -5809,7 +8978,10 @@ func (app *App) updateStatus() {
 			"Color mode: \033[32m%s\033[0m | "+
 			"Filter by date: \033[32m%s\033[0m | "+
 			"Filter by priority/boot: \033[32m%s\033[0m/\033[32m%s\033[0m | "+
-			"Show timestamp: \033[32m%t\033[0m \n "+
+			"Show timestamp: \033[32m%t\033[0m | "+
+			"Follow mode: \033[32m%t\033[0m \n "+
+			"Journal JSON: \033[32m%t\033[0m | "+
+			"Journal path/namespace: \033[32m%s\033[0m/\033[32m%s\033[0m | "+
 			"SSH mode: \033[32m%s\033[0m | "+
 			"Docker mode/context: \033[32m%s\033[0m/\033[32m%s\033[0m | "+
 			"Kubernetes context/namespace: \033[32m%s\033[0m/\033[32m%s\033[0m",
@@ -5821,6 +8993,10 @@ func (app *App) updateStatus() {
 		app.journalPriority,
 		app.journalBoot,
 		app.timestampDocker,
+		app.followMode,
+		app.journalJson,
+		app.journalPathStatus(),
+		app.journalNamespaceStatus(),
 		app.sshStatus,
 		app.dockerStreamLogsStatus,
 		app.dockerContext,
@@ -5829,23 +9005,30 @@ func (app *App) updateStatus() {
 	)
 }
 
+// Функция для фильтрации списка файлов по тексту фильтра списков
+func (app *App) filterLogFiles(logfiles []Logfile) []Logfile {
+	filter := strings.ToLower(app.filterListText)
+	var filteredLogFiles []Logfile
+	for _, logfile := range logfiles {
+		if strings.Contains(strings.ToLower(logfile.name), filter) {
+			filteredLogFiles = append(filteredLogFiles, logfile)
+		}
+	}
+	return filteredLogFiles
+}
+
 // Функция для фильтрации всех списоков журналов
 func (app *App) applyFilterList() {
 	filter := strings.ToLower(app.filterListText)
 	// Временные массивы для отфильтрованных журналов
 	var filteredJournals []Journal
-	var filteredLogFiles []Logfile
 	var filteredDockerContainers []DockerContainers
 	for _, j := range app.journalsNotFilter {
 		if strings.Contains(strings.ToLower(j.name), filter) {
 			filteredJournals = append(filteredJournals, j)
 		}
 	}
-	for _, j := range app.logfilesNotFilter {
-		if strings.Contains(strings.ToLower(j.name), filter) {
-			filteredLogFiles = append(filteredLogFiles, j)
-		}
-	}
+	filteredLogFiles := app.filterLogFiles(app.logfilesNotFilter)
 	for _, j := range app.dockerContainersNotFilter {
 		if strings.Contains(strings.ToLower(j.name), filter) {
 			filteredDockerContainers = append(filteredDockerContainers, j)
@@ -5901,11 +9084,11 @@ func (app *App) applyFilterList() {
 func (app *App) checkBin(commands []string) (string, error) {
 	var binName string
 	for _, command := range commands {
-		cmd := exec.Command(command, "--version")
-		if app.logging {
-			slog.Info(cmd.String(), "action", "Check the binary")
-		}
-		_, err := cmd.Output()
+		_, err := app.localCmdExecutor().Output(context.Background(), Command{
+			Name:   command,
+			Args:   []string{"--version"},
+			Action: "Check the binary",
+		})
 		if err == nil {
 			binName = command
 			break
@@ -5916,12 +9099,7 @@ func (app *App) checkBin(commands []string) (string, error) {
 		return binName, nil
 	} else {
 		if !app.testMode {
-			go func() {
-				errorText := strings.Join(commands, " and ") + " not found in environment"
-				app.showInterfaceInfo(g, true, errorText)
-				time.Sleep(3 * time.Second)
-				app.closeInfo(g)
-			}()
+			app.showInfo(true, strings.Join(commands, " and ")+" not found in environment", 3*time.Second)
 		}
 		return "", errors.New("binary file not found in environment")
 	}
@@ -5933,7 +9111,6 @@ func (app *App) applyFilter(color bool) {
 	var skip = false
 	var size int
 	var viewHeight int
-	var err error
 	if !app.testMode {
 		v, err := app.gui.View("filter")
 		if err != nil {
@@ -5961,108 +9138,29 @@ func (app *App) applyFilter(color bool) {
 		// Debug start color time
 		// Фиксируем время начала покраски журнала
 		startTime := time.Now()
-		// Если текст фильтра пустой или равен любому символу для regex, возвращяем вывод без фильтрации
-		if filter == "" || (filter == "." && app.selectFilterMode == "regex") ||
-			// Если длинна текста меньше флага минального кол-ва символов фильтра, пропускаем фильтрацию
-			len(filter) < app.minSymbolFilter {
-			app.filteredLogLines = app.currentLogLines
-		} else {
-			app.filteredLogLines = make([]string, 0)
-			// Опускаем регистр ввода текста для фильтра
-			filter = strings.ToLower(filter)
-			// Проверка регулярного выражения
-			var regex *regexp.Regexp
-			if app.selectFilterMode == "regex" {
-				// Добавляем флаг для нечувствительности к регистру по умолчанию
-				filter = "(?i)" + filter
-				// Компилируем регулярное выражение
-				regex, err = regexp.Compile(filter)
-				// В случае синтаксической ошибки регулярного выражения, красим окно красным цветом и завершаем цикл
-				if err != nil && !app.testMode {
-					v, _ := app.gui.View("filter")
-					v.FrameColor = app.errorColor
-					return
-				}
-				if err != nil && !app.testMode {
-					log.Print("Error: regex syntax")
-					return
-				}
-			}
-			// Проходимся по каждой строке
-			for _, line := range app.currentLogLines {
-				switch app.selectFilterMode {
-				// Fuzzy (неточный поиск без учета регистра)
-				case "fuzzy":
-					outputLine := app.fuzzyFilter(line, filter)
-					if outputLine != "" {
-						app.filteredLogLines = append(app.filteredLogLines, outputLine)
-					}
-				// Regex (с использованием регулярных выражений и без учета регистра по умолчанию)
-				case "regex":
-					outputLine := app.regexFilter(line, regex)
-					if outputLine != "" {
-						app.filteredLogLines = append(app.filteredLogLines, outputLine)
-					}
-				// Default (точный поиск с учетом регистра)
-				default:
-					filter = app.filterText
-					if filter == "" || strings.Contains(line, filter) {
-						lineColor := strings.ReplaceAll(line, filter, "\x1b[0;44m"+filter+"\033[0m")
-						app.filteredLogLines = append(app.filteredLogLines, lineColor)
-					}
-				}
-			}
+		// Записи вне отрезка времени фильтрации по дате не выводятся
+		records := app.filterRecordsByDate(app.currentLogRecords)
+		filteredRecords, filteredLines, err := app.filterRecords(records)
+		// В случае синтаксической ошибки регулярного выражения, красим окно красным цветом и завершаем цикл
+		if err != nil && !app.testMode {
+			v, _ := app.gui.View("filter")
+			v.FrameColor = app.errorColor
+			return
+		}
+		if err != nil && app.testMode {
+			log.Print("Error: regex syntax")
+			return
 		}
 		// Если последняя строка не содержит пустую строку, то добавляем две пустые строки или одну по умолчанию
-		if len(app.filteredLogLines) > 0 && app.filteredLogLines[len(app.filteredLogLines)-1] != "" {
-			app.filteredLogLines = append(app.filteredLogLines, "", "")
+		if len(filteredLines) > 0 && filteredLines[len(filteredLines)-1] != "" {
+			filteredRecords = append(filteredRecords, LogRecord{}, LogRecord{})
+			filteredLines = append(filteredLines, "", "")
 		} else {
-			app.filteredLogLines = append(app.filteredLogLines, "")
-		}
-		// Определяем режим покраски или пропускаем
-		switch app.colorMode {
-		case "default":
-			app.filteredLogLines = app.mainColor(app.filteredLogLines)
-		case "tailspin":
-			// Проверяем, что tailspin или tspin установлен в системе
-			binName, err := app.checkBin([]string{"tailspin", "tspin"})
-			if err == nil {
-				cmd := exec.Command(binName)
-				logLines := strings.Join(app.filteredLogLines, "\n")
-				// Создаем пайп для передачи данных
-				cmd.Stdin = bytes.NewBufferString(logLines)
-				var out bytes.Buffer
-				cmd.Stdout = &out
-				// Если ошибка, пропускаем покраску
-				if err := cmd.Run(); err == nil {
-					colorLogLines := strings.Split(out.String(), "\n")
-					app.filteredLogLines = colorLogLines
-				}
-			}
-		case "bat":
-			binName, err := app.checkBin([]string{"bat", "batcat"})
-			if err == nil {
-				cmd := exec.Command(
-					binName,
-					"--language=log",
-					"--paging=never",
-					"--style=plain",
-					"--color=always",
-					"--decorations=always",
-					"--theme=ansi",
-				)
-				logLines := strings.Join(app.filteredLogLines, "\n")
-				// Создаем пайп для передачи данных
-				cmd.Stdin = bytes.NewBufferString(logLines)
-				var out bytes.Buffer
-				cmd.Stdout = &out
-				// Если ошибка, пропускаем покраску
-				if err := cmd.Run(); err == nil {
-					colorLogLines := strings.Split(out.String(), "\n")
-					app.filteredLogLines = colorLogLines
-				}
-			}
+			filteredRecords = append(filteredRecords, LogRecord{})
+			filteredLines = append(filteredLines, "")
 		}
+		app.filteredLogLines = app.colorRecords(filteredRecords, filteredLines)
+		app.filteredLogRecords = filteredRecords
 		// Debug end time
 		endTime := time.Since(startTime)
 		app.debugColorTime = endTime.Truncate(time.Millisecond).String()
@@ -6086,6 +9184,143 @@ func (app *App) applyFilter(color bool) {
 	}
 }
 
+// Функция для фильтрации записей журнала по тексту и режиму фильтрации (возвращает записи и строки с выделением найденного текста)
+func (app *App) filterRecords(records []LogRecord) ([]LogRecord, []string, error) {
+	filter := app.filterText
+	// Если текст фильтра пустой или равен любому символу для regex, возвращяем вывод без фильтрации
+	if filter == "" || (filter == "." && app.selectFilterMode == "regex") ||
+		// Если длинна текста меньше флага минального кол-ва символов фильтра, пропускаем фильтрацию
+		len(filter) < app.minSymbolFilter {
+		lines := make([]string, 0, len(records))
+		for _, record := range records {
+			lines = append(lines, app.recordBody(record))
+		}
+		return slices.Clone(records), lines, nil
+	}
+	filteredRecords := make([]LogRecord, 0)
+	filteredLines := make([]string, 0)
+	// Опускаем регистр ввода текста для фильтра
+	filter = strings.ToLower(filter)
+	// Проверка регулярного выражения
+	var regex *regexp.Regexp
+	if app.selectFilterMode == "regex" {
+		// Добавляем флаг для нечувствительности к регистру по умолчанию
+		var err error
+		regex, err = regexp.Compile("(?i)" + filter)
+		// Журнал journald загружен с поиском journalctl --grep, который проверяет выражение PCRE2 только в поле MESSAGE,
+		// поэтому записи повторно не фильтруются по всей строке, а выражение используется только для выделения
+		if app.journalGrepApplied() {
+			for _, record := range records {
+				line := app.recordBody(record)
+				if err == nil {
+					if outputLine := app.filterLine(line, filter, regex); outputLine != "" {
+						line = outputLine
+					}
+				}
+				filteredRecords = append(filteredRecords, record)
+				filteredLines = append(filteredLines, line)
+			}
+			return filteredRecords, filteredLines, nil
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	// Проходимся по каждой записи
+	for _, record := range records {
+		line := app.recordBody(record)
+		outputLine := app.filterLine(line, filter, regex)
+		// Запись также подходит под фильтр по названию источника в префиксе (без выделения)
+		if outputLine == "" && app.recordPrefix(record) != "" && app.filterLine(record.prefixName(), filter, regex) != "" {
+			outputLine = line
+		}
+		if outputLine != "" {
+			filteredRecords = append(filteredRecords, record)
+			filteredLines = append(filteredLines, outputLine)
+		}
+	}
+	return filteredRecords, filteredLines, nil
+}
+
+// Функция для проверки строки в текущем режиме фильтрации (возвращает строку с выделением или пустую строку)
+func (app *App) filterLine(line, filter string, regex *regexp.Regexp) string {
+	switch app.selectFilterMode {
+	// Fuzzy (неточный поиск без учета регистра)
+	case "fuzzy":
+		return app.fuzzyFilter(line, filter)
+	// Regex (с использованием регулярных выражений и без учета регистра по умолчанию)
+	case "regex":
+		return app.regexFilter(line, regex)
+	// Default (точный поиск с учетом регистра)
+	default:
+		filter = app.filterText
+		if filter == "" || strings.Contains(line, filter) {
+			return strings.ReplaceAll(line, filter, "\x1b[0;44m"+filter+"\033[0m")
+		}
+	}
+	return ""
+}
+
+// Функция для покраски отфильтрованных записей (префикс источника красится по полю записи)
+func (app *App) colorRecords(records []LogRecord, lines []string) []string {
+	if app.colorMode != "default" {
+		outputLines := make([]string, len(lines))
+		for i, line := range lines {
+			outputLines[i] = app.recordPrefix(records[i]) + line
+		}
+		return app.colorLines(outputLines)
+	}
+	outputLines := app.mainColor(lines)
+	for i, record := range records {
+		if app.recordPrefix(record) != "" {
+			outputLines[i] = app.prefixColor(record) + outputLines[i]
+		}
+	}
+	return outputLines
+}
+
+// Функция для покраски строк журнала в текущем режиме покраски
+func (app *App) colorLines(lines []string) []string {
+	// Определяем режим покраски или пропускаем
+	switch app.colorMode {
+	case "default":
+		return app.mainColor(lines)
+	case "tailspin":
+		// Проверяем, что tailspin или tspin установлен в системе
+		binName, err := app.checkBin([]string{"tailspin", "tspin"})
+		if err == nil {
+			// Передаем строки в стандартный ввод, если ошибка, пропускаем покраску
+			out, err := app.localCmdExecutor().Output(context.Background(), Command{
+				Name:  binName,
+				Stdin: strings.NewReader(strings.Join(lines, "\n")),
+			})
+			if err == nil {
+				return strings.Split(string(out), "\n")
+			}
+		}
+	case "bat":
+		binName, err := app.checkBin([]string{"bat", "batcat"})
+		if err == nil {
+			out, err := app.localCmdExecutor().Output(context.Background(), Command{
+				Name: binName,
+				Args: []string{
+					"--language=log",
+					"--paging=never",
+					"--style=plain",
+					"--color=always",
+					"--decorations=always",
+					"--theme=ansi",
+				},
+				Stdin: strings.NewReader(strings.Join(lines, "\n")),
+			})
+			if err == nil {
+				return strings.Split(string(out), "\n")
+			}
+		}
+	}
+	return lines
+}
+
 // Fyzzy: Функция для неточного поиска (параметры: строка из цикла и текст фильтрации)
 func (app *App) fuzzyFilter(inputLine, filter string) string {
 	// Разбиваем текст фильтра на массив из строк
@@ -6311,22 +9546,6 @@ func (app *App) lineColor(inputLine string) string {
 	}
 	var colorLine string
 	var filterColor = false
-	// Извлекаем название контейнера в логах стека compose
-	var containerName string
-	if app.lastContainerizationSystem == "compose" {
-		// Исключаем строку с делиметром
-		if !strings.HasPrefix(inputLine, "⎯") {
-			// Извлекаем название контейнера
-			splitLine := strings.SplitN(inputLine, "] ", 2)
-			if len(splitLine) >= 2 {
-				containerName = splitLine[0]
-				// Удаляем первый индекс из названия контейнера (квадратная открывающиеся скобка)
-				containerName = containerName[1:]
-				// Удаляем название контейнера из покраски
-				inputLine = splitLine[1]
-			}
-		}
-	}
 	// Разбиваем строку по пробелам, сохраняя их
 	words := strings.Split(inputLine, " ")
 	var colorLineBuilder strings.Builder
@@ -6360,16 +9579,16 @@ func (app *App) lineColor(inputLine string) string {
 	colorLine = strings.ReplaceAll(colorLine, "not found", "\033[31mnot found\033[0m")
 	colorLine = strings.ReplaceAll(colorLine, "Bad request", "\033[31mBad request\033[0m")
 	colorLine = strings.ReplaceAll(colorLine, "bad request", "\033[31mbad request\033[0m")
-	// Возвращяем название контейнера с уникальной покраской
-	if app.lastContainerizationSystem == "compose" && containerName != "" {
-		if app.uniquePrefixColorMap[strings.TrimSpace(containerName)] != "" {
-			return "[" + app.uniquePrefixColorMap[strings.TrimSpace(containerName)] + containerName + "\033[0m" + "] " + colorLine
-		} else {
-			return "[" + containerName + "] " + colorLine
-		}
-	} else {
-		return colorLine
+	return colorLine
+}
+
+// Функция для покраски префикса записи (название контейнера compose или объединенного журнала с уникальной покраской)
+func (app *App) prefixColor(r LogRecord) string {
+	name := r.prefixName()
+	if color := app.uniquePrefixColorMap[name]; color != "" {
+		return "[" + color + name + "\033[0m" + "] "
 	}
+	return app.wordColor("["+name+"]") + " "
 }
 
 // Игнорируем регистр и проверяем, что слово окружено не буквами и цифрами
@@ -7256,11 +10475,13 @@ func (app *App) updateLogsView(lowerDown bool) {
 		}
 		// Индекс начала печати не должен быть меньше 0
 		printStart := max(len(app.filteredLogLines)-viewLines-1, 0)
+		app.logViewStart = printStart
 		for i := printStart; i < endLine; i++ {
 			fmt.Fprintln(v, app.filteredLogLines[i])
 		}
 	} else {
 		// Проходим по отфильтрованным строкам и выводим их
+		app.logViewStart = startLine
 		for i := startLine; i < endLine; i++ {
 			fmt.Fprintln(v, app.filteredLogLines[i])
 		}
@@ -7363,6 +10584,10 @@ func (app *App) scrollDownLogs(step int) error {
 
 // Функция для скроллинга вверх
 func (app *App) scrollUpLogs(step int) error {
+	// При прокрутке выше начала вывода загружаем предыдущий ротированный файл семейства
+	if app.logScrollPos-step < 0 {
+		app.loadFamilyMember()
+	}
 	app.logScrollPos -= step
 	if app.logScrollPos < 0 {
 		app.logScrollPos = 0
@@ -7378,6 +10603,9 @@ func (app *App) scrollUpLogs(step int) error {
 
 // Функция для переход к началу журнала
 func (app *App) pageUpLogs() {
+	if app.logScrollPos == 0 {
+		app.loadFamilyMember()
+	}
 	app.logScrollPos = 0
 	app.autoScroll = false
 	if !app.testMode {
@@ -7424,32 +10652,7 @@ func (app *App) updateLogOutput(newUpdate bool) {
 		if !app.testMode {
 			app.updateStatus()
 		}
-		switch app.lastWindow {
-		case "services":
-			if app.fastMode {
-				go func() {
-					app.loadJournalLogs(app.lastSelected, newUpdate)
-				}()
-			} else {
-				app.loadJournalLogs(app.lastSelected, newUpdate)
-			}
-		case "varLogs":
-			if app.fastMode {
-				go func() {
-					app.loadFileLogs(app.lastSelected, newUpdate)
-				}()
-			} else {
-				app.loadFileLogs(app.lastSelected, newUpdate)
-			}
-		case "docker":
-			if app.fastMode {
-				go func() {
-					app.loadDockerLogs(app.lastSelected, newUpdate)
-				}()
-			} else {
-				app.loadDockerLogs(app.lastSelected, newUpdate)
-			}
-		}
+		app.loadCurrentSource(newUpdate)
 		return nil
 	})
 }
@@ -7474,38 +10677,15 @@ func (app *App) updateLogBackground(secondsChan chan int, newUpdate bool) {
 			ticker.Reset(time.Duration(newSeconds) * time.Second)
 		// Когда срабатывает таймер, выполняем обновление логов
 		case <-ticker.C:
-			// Обновляем журнал только если включен автоскролл
-			if app.autoScroll {
-				app.gui.Update(func(g *gocui.Gui) error {
-					switch app.lastWindow {
-					case "services":
-						if app.fastMode {
-							go func() {
-								app.loadJournalLogs(app.lastSelected, newUpdate)
-							}()
-						} else {
-							app.loadJournalLogs(app.lastSelected, newUpdate)
-						}
-					case "varLogs":
-						if app.fastMode {
-							go func() {
-								app.loadFileLogs(app.lastSelected, newUpdate)
-							}()
-						} else {
-							app.loadFileLogs(app.lastSelected, newUpdate)
-						}
-					case "docker":
-						if app.fastMode {
-							go func() {
-								app.loadDockerLogs(app.lastSelected, newUpdate)
-							}()
-						} else {
-							app.loadDockerLogs(app.lastSelected, newUpdate)
-						}
-					}
-					return nil
-				})
-			}
+			// Состояние проверяется в основном потоке интерфейса
+			app.gui.Update(func(g *gocui.Gui) error {
+				// Обновляем журнал только если включен автоскролл
+				// В режиме follow новые строки поступают из потока, а медленную загрузку не прерываем повторным обновлением
+				if app.autoScroll && app.follower == nil && !app.loadInProgress() {
+					app.loadCurrentSource(newUpdate)
+				}
+				return nil
+			})
 		}
 	}
 }
@@ -7560,8 +10740,8 @@ func (app *App) updateWindowSize(seconds int) {
 func (app *App) updateDelimiter(newUpdate bool) {
 	if newUpdate {
 		// Фиксируем (сохраняем) предпоследнюю (-2, т.к. последняя строка всегда пустая) строку для вставки делимитра (если это ручной выбор из списка) или выходим
-		if len(app.currentLogLines) > 2 {
-			app.lastUpdateLine = app.currentLogLines[len(app.currentLogLines)-2]
+		if len(app.currentLogRecords) > 2 {
+			app.lastUpdateLine = app.recordLine(app.currentLogRecords[len(app.currentLogRecords)-2])
 		} else {
 			return
 		}
@@ -7577,33 +10757,44 @@ func (app *App) updateDelimiter(newUpdate bool) {
 		// Фиксируем новое время загрузки журнала
 		app.updateTime = time.Now().Format("15:04:05")
 	} else {
+		// Заполняем делимитр, добавленный при чтении новых записей после курсора (journald)
+		if i := slices.IndexFunc(app.currentLogRecords, isDelimiterRecord); i >= 0 {
+			if app.currentLogRecords[i].message == "" {
+				app.currentLogRecords[i].message = app.delimiterString()
+			}
+			return
+		}
 		// Ищем индекс строки в массиве с конца
 		delimiterIndex := 0
-		for i := len(app.currentLogLines) - 1; i >= 0; i-- {
-			if app.currentLogLines[i] == app.lastUpdateLine {
+		for i := len(app.currentLogRecords) - 1; i >= 0; i-- {
+			if app.recordLine(app.currentLogRecords[i]) == app.lastUpdateLine {
 				delimiterIndex = i
 				break
 			}
 		}
 		// Проверяем, что строка найдена и найденный индекс меньше длинны массива строк
-		if delimiterIndex > 0 && delimiterIndex < len(app.currentLogLines)-2 {
-			// Формируем длинну делимитра
-			v, _ := app.gui.View("logs")
-			width, _ := v.Size()
-			lengthDelimiter := width/2 - 5
-			delimiter1 := strings.Repeat("⎯", lengthDelimiter)
-			delimiter2 := delimiter1
-			if width > lengthDelimiter+lengthDelimiter+10 {
-				delimiter2 = strings.Repeat("⎯", lengthDelimiter+1)
-			}
-			var delimiterString = delimiter1 + " " + app.updateTime + " " + delimiter2
+		if delimiterIndex > 0 && delimiterIndex < len(app.currentLogRecords)-2 {
 			// Вставляем новую строку после указанного индекса + 1 пустая строка (сдвигая остальные строки массива)
-			app.currentLogLines = append(app.currentLogLines[:delimiterIndex+1],
-				append([]string{delimiterString}, app.currentLogLines[delimiterIndex+1:]...)...)
+			app.currentLogRecords = append(app.currentLogRecords[:delimiterIndex+1],
+				append([]LogRecord{{format: delimiterRecord, message: app.delimiterString()}}, app.currentLogRecords[delimiterIndex+1:]...)...)
 		}
 	}
 }
 
+// Функция для формирования строки делимитра по ширине окна вывода журнала
+func (app *App) delimiterString() string {
+	// Формируем длинну делимитра
+	v, _ := app.gui.View("logs")
+	width, _ := v.Size()
+	lengthDelimiter := width/2 - 5
+	delimiter1 := strings.Repeat("⎯", lengthDelimiter)
+	delimiter2 := delimiter1
+	if width > lengthDelimiter+lengthDelimiter+10 {
+		delimiter2 = strings.Repeat("⎯", lengthDelimiter+1)
+	}
+	return delimiter1 + " " + app.updateTime + " " + delimiter2
+}
+
 // ---------------------------------------- Key Binding ----------------------------------------
 
 // Карта для сопостовления сочетаний клавиш со значениями из конфигурации (#23)
@@ -8377,10 +11568,115 @@ func (app *App) setupKeybindings() error {
 	if err := app.gui.SetKeybinding("", customBackTab, altMode, app.backView); err != nil {
 		return err
 	}
-
-	// Enter для выбора службы и загрузки журналов
-	customEnter, altModeEnter := getHotkey(config.Hotkeys.LoadJournal, "enter")
-	if err := app.gui.SetKeybinding("services", customEnter, altModeEnter, app.selectService); err != nil {
+
+	// Enter для выбора службы и загрузки журналов
+	customEnter, altModeEnter := getHotkey(config.Hotkeys.LoadJournal, "enter")
+	if err := app.gui.SetKeybinding("services", customEnter, altModeEnter, app.selectService); err != nil {
+		return err
+	}
+	// Открытие и закрытие панели с информацией о юните (i)
+	customUnit, altMode := getHotkey(config.Hotkeys.ShowUnit, "i")
+	if err := app.gui.SetKeybinding("services", customUnit, altMode, app.toggleUnitDetail); err != nil {
+		return err
+	}
+	// Сортировка списка файлов по дате изменения, имени или размеру (s)
+	customSort, altMode := getHotkey(config.Hotkeys.SortFileList, "s")
+	if err := app.gui.SetKeybinding("varLogs", customSort, altMode, app.sortFileList); err != nil {
+		return err
+	}
+	// Объединение ротированных файлов журнала в окне файловой системы (r)
+	customFamily, altMode := getHotkey(config.Hotkeys.SwitchFamilyView, "r")
+	if err := app.gui.SetKeybinding("varLogs", customFamily, altMode, app.switchFamilyView); err != nil {
+		return err
+	}
+	// Построитель условий journald (m)
+	customMatch, altMode := getHotkey(config.Hotkeys.MatchBuilder, "m")
+	if err := app.gui.SetKeybinding("services", customMatch, altMode, func(g *gocui.Gui, v *gocui.View) error {
+		if app.getOS == "windows" {
+			return nil
+		}
+		// Список полей загружается один раз за открытие построителя
+		app.matchSession++
+		app.matchTerms = nil
+		app.matchField = ""
+		app.matchFields = nil
+		app.matchOptions = nil
+		app.showInterfaceMatch(g)
+		app.loadMatchOptions(g, "")
+		g.DeleteKeybindings("")
+		for _, viewName := range mainViews {
+			g.DeleteKeybindings(viewName)
+		}
+		customUp, _ := getHotkey(config.Hotkeys.Up, "k")
+		customDown, _ := getHotkey(config.Hotkeys.Down, "j")
+		for _, key := range []any{gocui.KeyArrowUp, customUp} {
+			if err := g.SetKeybinding("match", key, gocui.ModNone, app.moveCursorUp); err != nil {
+				return err
+			}
+		}
+		for _, key := range []any{gocui.KeyArrowDown, customDown} {
+			if err := g.SetKeybinding("match", key, gocui.ModNone, app.moveCursorDown); err != nil {
+				return err
+			}
+		}
+		// Выбор поля или значения под курсором
+		if err := g.SetKeybinding("match", gocui.KeyEnter, gocui.ModNone, app.selectMatchOption); err != nil {
+			return err
+		}
+		// Дизъюнкция условий
+		if err := g.SetKeybinding("match", '+', gocui.ModNone, func(g *gocui.Gui, v *gocui.View) error {
+			if len(app.matchTerms) != 0 && app.matchTerms[len(app.matchTerms)-1] != "+" {
+				app.matchTerms = append(app.matchTerms, "+")
+				app.showInterfaceMatch(g)
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		// Удаление последнего условия
+		for _, key := range []gocui.Key{gocui.KeyBackspace, gocui.KeyBackspace2} {
+			if err := g.SetKeybinding("match", key, gocui.ModNone, func(g *gocui.Gui, v *gocui.View) error {
+				if len(app.matchTerms) != 0 {
+					app.matchTerms = app.matchTerms[:len(app.matchTerms)-1]
+					app.showInterfaceMatch(g)
+				}
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		// Сохранение выражения в списке системных журналов и конфигурации
+		if err := g.SetKeybinding("match", gocui.KeyCtrlS, gocui.ModNone, func(g *gocui.Gui, v *gocui.View) error {
+			if !app.saveMatch() {
+				return nil
+			}
+			return app.closeMatch(g)
+		}); err != nil {
+			return err
+		}
+		// Возврат к списку полей или закрытие построителя
+		if err := g.SetKeybinding("match", gocui.KeyEsc, gocui.ModNone, func(g *gocui.Gui, v *gocui.View) error {
+			if app.matchField != "" {
+				app.matchField = ""
+				app.matchLoading = false
+				app.matchOptions = app.matchFields
+				app.showInterfaceMatch(g)
+				return nil
+			}
+			return app.closeMatch(g)
+		}); err != nil {
+			return err
+		}
+		if err := g.SetKeybinding("match", gocui.KeyCtrlC, gocui.ModNone, quit); err != nil {
+			return err
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	// Space для отметки журналов для объединенного вывода
+	customMark, altMode := getHotkey(config.Hotkeys.MarkJournal, "space")
+	if err := app.gui.SetKeybinding("services", customMark, altMode, app.markService); err != nil {
 		return err
 	}
 	if err := app.gui.SetKeybinding("varLogs", customEnter, altModeEnter, app.selectFile); err != nil {
@@ -8572,13 +11868,7 @@ func (app *App) setupKeybindings() error {
 	// Обновить все текущие списки журналов вручную
 	customUpdateLists, altMode := getHotkey(config.Hotkeys.UpdateLists, "ctrl+q")
 	if err := app.gui.SetKeybinding("", customUpdateLists, altMode, func(g *gocui.Gui, v *gocui.View) error {
-		if app.getOS != "windows" {
-			app.loadServices(app.selectUnits)
-			app.loadFiles(app.selectPath)
-		} else {
-			app.loadWinFiles(app.selectPath)
-		}
-		app.loadDockerContainer(app.selectContainerizationSystem)
+		app.updateLists()
 		return nil
 	}); err != nil {
 		return err
@@ -8597,7 +11887,7 @@ func (app *App) setupKeybindings() error {
 		case "bat":
 			app.colorMode = "disable"
 		}
-		if len(app.currentLogLines) != 0 {
+		if len(app.currentLogRecords) != 0 {
 			app.updateLogsView(true)
 			app.applyFilter(false)
 			app.updateLogOutput(false)
@@ -8629,7 +11919,7 @@ func (app *App) setupKeybindings() error {
 		case "emerg":
 			app.journalPriority = "debug"
 		}
-		if len(app.currentLogLines) != 0 {
+		if len(app.currentLogRecords) != 0 {
 			app.updateLogsView(true)
 			app.applyFilter(false)
 			app.updateLogOutput(false)
@@ -8640,6 +11930,12 @@ func (app *App) setupKeybindings() error {
 		return err
 	}
 
+	// switch namespace for journald (Ctrl+N)
+	customNamespace, altMode := getHotkey(config.Hotkeys.SwitchNamespace, "ctrl+n")
+	if err := app.gui.SetKeybinding("", customNamespace, altMode, app.switchJournalNamespace); err != nil {
+		return err
+	}
+
 	// docker log load mode from stream or file system (Ctrl+D)
 	// Переключение режима чтения журналов Docker из потоков или файловой системы
 	customDockerMode, altMode := getHotkey(config.Hotkeys.SwitchDockerMode, "ctrl+d")
@@ -8691,6 +11987,108 @@ func (app *App) setupKeybindings() error {
 		return err
 	}
 
+	// follow mode (Ctrl+F)
+	// Переключение режима чтения новых строк из постоянного потока или обновления по таймеру
+	customFollowMode, altMode := getHotkey(config.Hotkeys.SwitchFollowMode, "ctrl+f")
+	if err := app.gui.SetKeybinding("", customFollowMode, altMode, func(g *gocui.Gui, v *gocui.View) error {
+		if app.followMode {
+			app.followMode = false
+			app.stopFollow()
+		} else {
+			app.followMode = true
+		}
+		app.updateLogOutput(false)
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	// journald JSON mode (Ctrl+O)
+	// Переключение чтения журналов journald в формате JSON со всеми полями записи
+	customJournalJson, altMode := getHotkey(config.Hotkeys.SwitchJournalJson, "ctrl+o")
+	if err := app.gui.SetKeybinding("", customJournalJson, altMode, func(g *gocui.Gui, v *gocui.View) error {
+		app.journalJson = !app.journalJson
+		app.updateStatus()
+		if _, ok := app.currentSource.(*journaldSource); ok {
+			app.updateLogOutput(false)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	// field inspector (i)
+	// Открытие окна с полями записи журнала в строке под курсором
+	customFields, altMode := getHotkey(config.Hotkeys.ShowFields, "i")
+	fieldsHandler := func(g *gocui.Gui, v *gocui.View) error {
+		index := app.selectedLogRecordIndex(v)
+		if index < 0 {
+			return nil
+		}
+		app.fieldsRecords = slices.Clone(app.filteredLogRecords)
+		app.fieldsIndex = index
+		app.showInterfaceFields(g)
+		if _, err := g.View("fields"); err != nil {
+			return nil
+		}
+		g.DeleteKeybindings("")
+		for _, viewName := range mainViews {
+			g.DeleteKeybindings(viewName)
+		}
+		// Пролистывание полей и переключение между записями журнала
+		customUp, _ := getHotkey(config.Hotkeys.Up, "k")
+		customDown, _ := getHotkey(config.Hotkeys.Down, "j")
+		customLeft, _ := getHotkey(config.Hotkeys.Left, "h")
+		customRight, _ := getHotkey(config.Hotkeys.Right, "l")
+		for _, key := range []any{gocui.KeyArrowUp, customUp} {
+			if err := g.SetKeybinding("fields", key, gocui.ModNone, app.moveCursorUp); err != nil {
+				return err
+			}
+		}
+		for _, key := range []any{gocui.KeyArrowDown, customDown} {
+			if err := g.SetKeybinding("fields", key, gocui.ModNone, app.moveCursorDown); err != nil {
+				return err
+			}
+		}
+		for _, key := range []any{gocui.KeyArrowLeft, customLeft} {
+			if err := g.SetKeybinding("fields", key, gocui.ModNone, func(g *gocui.Gui, v *gocui.View) error {
+				app.switchFieldsRecord(g, -1)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		for _, key := range []any{gocui.KeyArrowRight, customRight} {
+			if err := g.SetKeybinding("fields", key, gocui.ModNone, func(g *gocui.Gui, v *gocui.View) error {
+				app.switchFieldsRecord(g, 1)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		// Закрытие окна
+		closeHandler := func(g *gocui.Gui, v *gocui.View) error {
+			app.closeFields(g)
+			if err := app.setupKeybindings(); err != nil {
+				log.Panicln("Error key bindings", err)
+			}
+			return app.setSelectView(g, "logs")
+		}
+		if err := g.SetKeybinding("fields", gocui.KeyEsc, gocui.ModNone, closeHandler); err != nil {
+			return err
+		}
+		if err := g.SetKeybinding("fields", customFields, altMode, closeHandler); err != nil {
+			return err
+		}
+		if err := g.SetKeybinding("fields", gocui.KeyCtrlC, gocui.ModNone, quit); err != nil {
+			return err
+		}
+		return nil
+	}
+	if err := app.gui.SetKeybinding("logs", customFields, altMode, fieldsHandler); err != nil {
+		return err
+	}
+
 	// Exit (ctrl+c)
 	// Очистка поля ввода для фильтрации списков или выход
 	customExit, altMode := getHotkey(config.Hotkeys.Exit, "ctrl+c")
@@ -8873,114 +12271,587 @@ func (app *App) setupKeybindings() error {
 	}); err != nil {
 		return err
 	}
-	if err := app.gui.SetKeybinding("docker", gocui.MouseWheelUp, gocui.ModNone, func(g *gocui.Gui, v *gocui.View) error {
-		return app.prevDockerContainer(v, 1)
-	}); err != nil {
-		return err
+	if err := app.gui.SetKeybinding("docker", gocui.MouseWheelUp, gocui.ModNone, func(g *gocui.Gui, v *gocui.View) error {
+		return app.prevDockerContainer(v, 1)
+	}); err != nil {
+		return err
+	}
+	if err := app.gui.SetKeybinding("docker", gocui.MouseWheelDown, gocui.ModNone, func(g *gocui.Gui, v *gocui.View) error {
+		return app.nextDockerContainer(v, 1)
+	}); err != nil {
+		return err
+	}
+	// Скроллинг по журналу через 1 или 100 (alt/ctrl) строк
+	if err := app.gui.SetKeybinding("logs", gocui.MouseWheelUp, gocui.ModNone, func(g *gocui.Gui, v *gocui.View) error {
+		return app.scrollUpLogs(1)
+	}); err != nil {
+		return err
+	}
+	if err := app.gui.SetKeybinding("logs", gocui.MouseWheelUp, gocui.ModAlt, func(g *gocui.Gui, v *gocui.View) error {
+		return app.scrollUpLogs(100)
+	}); err != nil {
+		return err
+	}
+	if err := app.gui.SetKeybinding("logs", gocui.MouseWheelUp, gocui.ModMouseCtrl, func(g *gocui.Gui, v *gocui.View) error {
+		return app.scrollUpLogs(100)
+	}); err != nil {
+		return err
+	}
+	if err := app.gui.SetKeybinding("logs", gocui.MouseWheelDown, gocui.ModNone, func(g *gocui.Gui, v *gocui.View) error {
+		return app.scrollDownLogs(1)
+	}); err != nil {
+		return err
+	}
+	if err := app.gui.SetKeybinding("logs", gocui.MouseWheelDown, gocui.ModAlt, func(g *gocui.Gui, v *gocui.View) error {
+		return app.scrollDownLogs(100)
+	}); err != nil {
+		return err
+	}
+	if err := app.gui.SetKeybinding("logs", gocui.MouseWheelDown, gocui.ModMouseCtrl, func(g *gocui.Gui, v *gocui.View) error {
+		return app.scrollDownLogs(100)
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Интерфейс справки (F1)
+func (app *App) showInterfaceHelp(g *gocui.Gui) {
+	// Получаем размеры терминала
+	maxX, maxY := g.Size()
+	// Размеры окна help
+	width, height := 108, 54
+	// Вычисляем координаты для центрального расположения
+	x0 := (maxX - width) / 2
+	y0 := (maxY - height) / 2
+	x1 := x0 + width
+	y1 := y0 + height
+	helpView, err := g.SetView("help", x0, y0, x1, y1, 0)
+	if err != nil && !errors.Is(err, gocui.ErrUnknownView) {
+		return
+	}
+	helpView.Title = " Help "
+	helpView.Autoscroll = true
+	helpView.Wrap = true
+	helpView.FrameColor = app.selectedFrameColor
+	helpView.TitleColor = app.selectedTitleColor
+	helpView.Clear()
+	fmt.Fprintln(helpView, "\n                   \033[32m_                              \033[36m_                                    _ ")
+	fmt.Fprintln(helpView, "                  \033[32m| |                            \033[36m| |                                  | |")
+	fmt.Fprintln(helpView, "                  \033[32m| |      __ _  ____ _   _      \033[36m| |  ___   _   _  _ __  _ __    __ _ | |")
+	fmt.Fprintln(helpView, "                  \033[32m| |     / _` ||_  /| | | | \033[36m_   | | / _ \\ | | | || '__|| '_ \\  / _` || |")
+	fmt.Fprintln(helpView, "                  \033[32m| |____| (_| | / / | |_| |\033[36m| |__| || (_) || |_| || |   | | | || (_| || |")
+	fmt.Fprintln(helpView, "                  \033[32m|______|\\__,_|/___| \\__, | \033[36m\\____/  \\___/  \\__,_||_|   |_| |_| \\__,_||_|")
+	fmt.Fprintln(helpView, "                  \033[32m					 __/ |                                             ")
+	fmt.Fprintln(helpView, "                  \033[32m                    |___/\033[0m")
+	fmt.Fprintln(helpView, "\n    Version: "+app.wordColor(appVersion))
+	fmt.Fprintln(helpView, "\n    Hotkeys description (default values):")
+	fmt.Fprintln(helpView, "\n      \033[32mF2\033[0m - interface for ssh manager and contexts switching.")
+	fmt.Fprintln(helpView, "      \033[32mTab\033[0m - switch to next window.")
+	fmt.Fprintln(helpView, "      \033[32mShift\033[0m+\033[32mTab\033[0m - return to previous window.")
+	fmt.Fprintln(helpView, "      \033[32mUp\033[0m/\033[32mPgUp\033[0m/\033[32mk\033[0m and \033[32mDown\033[0m/\033[32mPgDown\033[0m/\033[32mj\033[0m - move up and down through all journal lists and log output,")
+	fmt.Fprintln(helpView, "      as well as changing the filtering mode in the filter window.")
+	fmt.Fprintln(helpView, "      \033[32mShift\033[0m/\033[32mAlt\033[0m+\033[32mUp\033[0m/\033[32mDown\033[0m - quickly move up and down through all journal lists and log output")
+	fmt.Fprintln(helpView, "      every 10 or 100 lines (500 for log output).")
+	fmt.Fprintln(helpView, "      \033[32mShift\033[0m/\033[32mCtrl\033[0m+\033[32mk\033[0m/\033[32mj\033[0m - quickly move up and down (like Vim and alternative for macOS from config).")
+	fmt.Fprintln(helpView, "      \033[32mLeft\033[0m/\033[32mh\033[0m and \033[32mRight\033[0m/\033[32ml\033[0m - switch between journal lists in the selected window and change the date")
+	fmt.Fprintln(helpView, "      in the filter window.")
+	fmt.Fprintln(helpView, "      \033[32mDel\033[0m/\033[32mBackspace\033[0m - disable filtering by date.")
+	fmt.Fprintln(helpView, "      \033[32mEnter\033[0m - load a log from the list window or return to the previous window from the filter window.")
+	fmt.Fprintln(helpView, "      \033[32mi\033[0m - show or hide details of the unit under the cursor in the services window (systemctl show and unit file).")
+	fmt.Fprintln(helpView, "      \033[32mm\033[0m - build a journald match from fields and values (Enter - select, + - or, Backspace - undo, Ctrl+S - save).")
+	fmt.Fprintln(helpView, "      \033[32ms\033[0m - sort the file system list by modification time, name or size (files updated in the last minute are marked as active).")
+	fmt.Fprintln(helpView, "      \033[32mr\033[0m - join rotated log files into one entry in the file system window (older files are loaded when scrolling up).")
+	fmt.Fprintln(helpView, "      \033[32mSpace\033[0m - mark units or journals to load them as one merged log with a prefix (marking _all clears all marks).")
+	fmt.Fprintln(helpView, "      or mark two kernel boots to compare their messages (new in the later boot +, missing -).")
+	fmt.Fprintln(helpView, "      \033[32m/\033[0m - go to the filter window from the current list window or logs window.")
+	fmt.Fprintln(helpView, "      \033[32mEnd\033[0m/\033[32mCtrl\033[0m+\033[32mE\033[0m - go to the end of the log.")
+	fmt.Fprintln(helpView, "      \033[32mHome\033[0m/\033[32mCtrl\033[0m+\033[32mA\033[0m - go to the top of the log.")
+	fmt.Fprintln(helpView, "      \033[32m[\033[0m/\033[32m]\033[0m - change the number of log lines to output (range: 200-200000, default: 10K).")
+	fmt.Fprintln(helpView, "      \033[32m{\033[0m/\033[32m}\033[0m - change the update interval of the log output (range: 2-10, default: 5).")
+	fmt.Fprintln(helpView, "      \033[32mCtrl\033[0m+\033[32mU\033[0m - disable streaming of new events (log is loaded once without update).")
+	fmt.Fprintln(helpView, "      \033[32mCtrl\033[0m+\033[32mR\033[0m - update the current log output manually (relevant in disable streaming mode).")
+	fmt.Fprintln(helpView, "      \033[32mCtrl\033[0m+\033[32mQ\033[0m - update all log lists.")
+	fmt.Fprintln(helpView, "      \033[32mCtrl\033[0m+\033[32mW\033[0m - switch color mode between default, tailspin, bat or disable.")
+	fmt.Fprintln(helpView, "      \033[32mCtrl\033[0m+\033[32mN\033[0m - switch journald namespace for the lists of units and journals (default, found namespaces or all).")
+	fmt.Fprintln(helpView, "      \033[32mCtrl\033[0m+\033[32mD\033[0m - change read mode for docker logs (stream only or json from file system).")
+	fmt.Fprintln(helpView, "      \033[32mCtrl\033[0m+\033[32mS\033[0m - change stream display mode for docker logs (all, stdout or stderr only).")
+	fmt.Fprintln(helpView, "      \033[32mCtrl\033[0m+\033[32mT\033[0m - enable or disable built-in timestamp for Docker and Kubernetes logs.")
+	fmt.Fprintln(helpView, "      \033[32mCtrl\033[0m+\033[32mF\033[0m - enable or disable follow mode (new events are read from a persistent stream).")
+	fmt.Fprintln(helpView, "      \033[32mCtrl\033[0m+\033[32mO\033[0m - enable or disable reading of journald logs in JSON format (all fields of the entry).")
+	fmt.Fprintln(helpView, "      \033[32mi\033[0m - show all fields of the log entry selected with the mouse in the logs window (the first visible line")
+	fmt.Fprintln(helpView, "      by default), use \033[32mLeft\033[0m/\033[32mRight\033[0m to switch to the previous or next entry and \033[32mEsc\033[0m to close.")
+	fmt.Fprintln(helpView, "      \033[32mCtrl\033[0m+\033[32mC\033[0m - clear input text in the filter window or exit.")
+	fmt.Fprintln(helpView, "\n    Source code: "+app.wordColor("https://github.com/Lifailon/lazyjournal"))
+}
+
+func (app *App) closeHelp(g *gocui.Gui) {
+	if err := g.DeleteView("help"); err != nil {
+		return
+	}
+}
+
+// Функция для определения индекса записи журнала в строке окна вывода под курсором (с учетом переноса строк)
+func (app *App) selectedLogRecordIndex(v *gocui.View) int {
+	width, _ := v.Size()
+	_, cy := v.Cursor()
+	row := 0
+	for i := app.logViewStart; i < len(app.filteredLogLines) && i < len(app.filteredLogRecords); i++ {
+		row++
+		if v.Wrap && width > 0 {
+			if length := len([]rune(ansiEscape.ReplaceAllString(app.filteredLogLines[i], ""))); length > width {
+				row += (length - 1) / width
+			}
+		}
+		if cy < row {
+			return i
+		}
+	}
+	return -1
+}
+
+// Функция для формирования списка полей записи журнала (поля разобранной строки и все поля journald в формате JSON)
+func (r LogRecord) fieldLines() []string {
+	var lines []string
+	addField := func(name, value string) {
+		if value != "" {
+			lines = append(lines, "\033[32m"+name+"\033[0m="+strings.ReplaceAll(value, "\n", "\n  "))
+		}
+	}
+	if !r.timestamp.IsZero() {
+		addField("timestamp", r.timestamp.Format(time.RFC3339Nano))
+	}
+	addField("source", r.source)
+	addField("level", r.level)
+	addField("stream", r.stream)
+	addField("host", r.host)
+	addField("message", r.message)
+	for _, name := range slices.Sorted(maps.Keys(r.fields)) {
+		addField(name, r.fields[name])
+	}
+	return lines
+}
+
+// Интерфейс для просмотра всех полей выбранной записи журнала (справа в окне вывода журнала)
+func (app *App) showInterfaceFields(g *gocui.Gui) {
+	vLogs, err := g.View("logs")
+	if err != nil || app.fieldsIndex < 0 || app.fieldsIndex >= len(app.fieldsRecords) {
+		return
+	}
+	x0, y0, x1, y1 := vLogs.Dimensions()
+	fieldsView, err := g.SetView("fields", x0+(x1-x0)/2, y0, x1, y1, 0)
+	if err != nil && !errors.Is(err, gocui.ErrUnknownView) {
+		return
+	}
+	fieldsView.Title = fmt.Sprintf(" Fields (%d/%d) ", app.fieldsIndex+1, len(app.fieldsRecords))
+	fieldsView.Highlight = true
+	fieldsView.Wrap = true
+	fieldsView.FrameColor = app.selectedFrameColor
+	fieldsView.TitleColor = app.selectedTitleColor
+	fieldsView.SelFgColor = app.selectedForegroundColor
+	fieldsView.SelBgColor = app.selectedBackgroundColor
+	fieldsView.Clear()
+	_ = fieldsView.SetCursor(0, 0)
+	_ = fieldsView.SetOrigin(0, 0)
+	for _, line := range app.fieldsRecords[app.fieldsIndex].fieldLines() {
+		fmt.Fprintln(fieldsView, line)
+	}
+	if _, err := g.SetCurrentView("fields"); err != nil {
+		return
+	}
+}
+
+// Функция для перехода к предыдущей или следующей записи в окне просмотра полей (пустые записи пропускаются)
+func (app *App) switchFieldsRecord(g *gocui.Gui, step int) {
+	for i := app.fieldsIndex + step; i >= 0 && i < len(app.fieldsRecords); i += step {
+		if len(app.fieldsRecords[i].fieldLines()) != 0 {
+			app.fieldsIndex = i
+			app.showInterfaceFields(g)
+			return
+		}
+	}
+}
+
+func (app *App) closeFields(g *gocui.Gui) {
+	g.DeleteKeybindings("fields")
+	if err := g.DeleteView("fields"); err != nil {
+		return
+	}
+}
+
+// Интерфейс построителя условий journald (список полей или значений выбранного поля)
+func (app *App) showInterfaceMatch(g *gocui.Gui) {
+	maxX, maxY := g.Size()
+	width, height := min(90, maxX-2), min(30, maxY-2)
+	x0 := (maxX - width) / 2
+	y0 := (maxY - height) / 2
+	matchView, err := g.SetView("match", x0, y0, x0+width, y0+height, 0)
+	if err != nil && !errors.Is(err, gocui.ErrUnknownView) {
+		return
+	}
+	expr := journalMatchExpr(app.matchTerms)
+	if app.matchField != "" {
+		expr = strings.TrimSpace(expr + " " + app.matchField + "=")
+	}
+	matchView.Title = " Match: " + expr + " "
+	matchView.Subtitle = " Enter - select | + - or | Backspace - undo | Ctrl+S - save | Esc - back "
+	matchView.Highlight = true
+	matchView.Wrap = false
+	matchView.FrameColor = app.selectedFrameColor
+	matchView.TitleColor = app.selectedTitleColor
+	matchView.SelFgColor = app.selectedForegroundColor
+	matchView.SelBgColor = app.selectedBackgroundColor
+	matchView.Clear()
+	_ = matchView.SetCursor(0, 0)
+	_ = matchView.SetOrigin(0, 0)
+	if app.matchLoading {
+		matchView.Highlight = false
+		fmt.Fprintln(matchView, "\033[33mLoading...\033[0m")
+	}
+	for _, option := range app.matchOptions {
+		fmt.Fprintln(matchView, option)
+	}
+	if _, err := g.SetCurrentView("match"); err != nil {
+		return
+	}
+}
+
+// Функция для выбора поля (переход к списку значений) или значения (добавление условия FIELD=value) в построителе
+func (app *App) selectMatchOption(g *gocui.Gui, v *gocui.View) error {
+	if app.matchLoading {
+		return nil
+	}
+	_, cy := v.Cursor()
+	line, err := v.Line(cy)
+	if err != nil || line == "" {
+		return nil
+	}
+	if app.matchField == "" {
+		app.matchField = line
+		app.matchOptions = nil
+		app.loadMatchOptions(g, line)
+	} else {
+		app.matchTerms = append(app.matchTerms, app.matchField+"="+line)
+		app.matchField = ""
+		app.matchOptions = app.matchFields
+	}
+	app.showInterfaceMatch(g)
+	return nil
+}
+
+// Функция для загрузки списка полей (field не задан) или значений поля построителя в горутине
+func (app *App) loadMatchOptions(g *gocui.Gui, field string) {
+	app.matchLoading = true
+	session := app.matchSession
+	worker := app.loadWorker()
+	go func() {
+		var options []string
+		var err error
+		if field == "" {
+			options, err = worker.journalCheck("fields")
+			sort.Strings(options)
+		} else {
+			options = worker.journalFieldValues(field)
+		}
+		g.Update(func(g *gocui.Gui) error {
+			// Результат не выводится, если построитель закрыт или выбран другой шаг
+			if session != app.matchSession || field != app.matchField {
+				return nil
+			}
+			app.matchLoading = false
+			if err != nil {
+				app.showInfo(true, "Error getting fields from journald: "+err.Error(), 5*time.Second)
+				return app.closeMatch(g)
+			}
+			if field == "" {
+				app.matchFields = options
+			}
+			app.matchOptions = options
+			app.showInterfaceMatch(g)
+			return nil
+		})
+	}()
+}
+
+// Функция для закрытия построителя условий с возвратом к списку журналов
+func (app *App) closeMatch(g *gocui.Gui) error {
+	app.matchSession++
+	app.matchLoading = false
+	g.DeleteKeybindings("match")
+	_ = g.DeleteView("match")
+	if err := app.setupKeybindings(); err != nil {
+		log.Panicln("Error key bindings", err)
+	}
+	return app.setSelectView(g, "services")
+}
+
+// Функция для получения уникальных значений поля журнала (journalctl --field)
+func (app *App) journalFieldValues(field string) []string {
+	output, err := app.runCommand("Loading the field values", "journalctl", append(app.journalSourceArgs(), "--no-pager", "--field="+field)...)
+	if err != nil {
+		return nil
+	}
+	var values []string
+	valueMap := make(map[string]bool)
+	for line := range strings.SplitSeq(string(output), "\n") {
+		if value := strings.TrimSpace(line); value != "" && !valueMap[value] {
+			valueMap[value] = true
+			values = append(values, value)
+		}
 	}
-	if err := app.gui.SetKeybinding("docker", gocui.MouseWheelDown, gocui.ModNone, func(g *gocui.Gui, v *gocui.View) error {
-		return app.nextDockerContainer(v, 1)
-	}); err != nil {
-		return err
+	sort.Strings(values)
+	return values
+}
+
+// Функция для сохранения выражения из построителя в списке системных журналов и конфигурации
+func (app *App) saveMatch() bool {
+	terms := app.matchTerms
+	for len(terms) != 0 && terms[len(terms)-1] == "+" {
+		terms = terms[:len(terms)-1]
 	}
-	// Скроллинг по журналу через 1 или 100 (alt/ctrl) строк
-	if err := app.gui.SetKeybinding("logs", gocui.MouseWheelUp, gocui.ModNone, func(g *gocui.Gui, v *gocui.View) error {
-		return app.scrollUpLogs(1)
-	}); err != nil {
-		return err
+	expr := journalMatchExpr(terms)
+	if !isJournalMatch(expr) {
+		app.showInfo(true, "Match is empty, select a field and a value", 3*time.Second)
+		return false
 	}
-	if err := app.gui.SetKeybinding("logs", gocui.MouseWheelUp, gocui.ModAlt, func(g *gocui.Gui, v *gocui.View) error {
-		return app.scrollUpLogs(100)
-	}); err != nil {
-		return err
+	if !slices.Contains(app.journalMatches, expr) {
+		app.journalMatches = append(slices.Clone(app.journalMatches), expr)
 	}
-	if err := app.gui.SetKeybinding("logs", gocui.MouseWheelUp, gocui.ModMouseCtrl, func(g *gocui.Gui, v *gocui.View) error {
-		return app.scrollUpLogs(100)
-	}); err != nil {
-		return err
+	var current Config
+	configPath, err := current.getConfig()
+	if err == nil || errors.Is(err, ErrConfigNotFound) {
+		err = saveJournalMatches(configPath, app.journalMatches)
 	}
-	if err := app.gui.SetKeybinding("logs", gocui.MouseWheelDown, gocui.ModNone, func(g *gocui.Gui, v *gocui.View) error {
-		return app.scrollDownLogs(1)
-	}); err != nil {
-		return err
+	if err != nil {
+		app.showInfo(true, "Error saving match to configuration: "+err.Error(), 5*time.Second)
 	}
-	if err := app.gui.SetKeybinding("logs", gocui.MouseWheelDown, gocui.ModAlt, func(g *gocui.Gui, v *gocui.View) error {
-		return app.scrollDownLogs(100)
-	}); err != nil {
-		return err
+	if app.selectUnits == "systemJournals" {
+		app.loadServices(app.selectUnits)
 	}
-	if err := app.gui.SetKeybinding("logs", gocui.MouseWheelDown, gocui.ModMouseCtrl, func(g *gocui.Gui, v *gocui.View) error {
-		return app.scrollDownLogs(100)
-	}); err != nil {
-		return err
+	return true
+}
+
+// Функция для переключения пространства имен journald по кругу (по умолчанию, найденные пространства и все пространства)
+func (app *App) switchJournalNamespace(g *gocui.Gui, v *gocui.View) error {
+	if app.journalPath != "" || app.getOS == "windows" {
+		app.showInfo(true, "Journal namespaces are not available for offline journal", 3*time.Second)
+		return nil
 	}
+	namespaces := append([]string{""}, app.journalNamespaces()...)
+	namespaces = append(namespaces, "*")
+	index := slices.Index(namespaces, app.journalNamespace)
+	app.journalNamespace = namespaces[(index+1)%len(namespaces)]
+	app.markedJournals = nil
+	// Обновляем список юнитов или журналов из выбранного пространства имен
+	app.loadServices(app.selectUnits)
+	app.updateStatus()
+	return nil
+}
 
+// Функция для открытия или закрытия панели с информацией о юните под курсором
+func (app *App) toggleUnitDetail(g *gocui.Gui, v *gocui.View) error {
+	app.unitDetail = !app.unitDetail
+	if !app.unitDetail {
+		g.DeleteKeybindings("unit")
+		_ = g.DeleteView("unit")
+		return nil
+	}
+	app.updateUnitDetail()
 	return nil
 }
 
-// Интерфейс справки (F1)
-func (app *App) showInterfaceHelp(g *gocui.Gui) {
-	// Получаем размеры терминала
-	maxX, maxY := g.Size()
-	// Размеры окна help
-	width, height := 108, 43
-	// Вычисляем координаты для центрального расположения
-	x0 := (maxX - width) / 2
-	y0 := (maxY - height) / 2
-	x1 := x0 + width
-	y1 := y0 + height
-	helpView, err := g.SetView("help", x0, y0, x1, y1, 0)
+// Интерфейс информации о юните под курсором в списке служб (поверх правой половины окна вывода журнала)
+func (app *App) updateUnitDetail() {
+	if !app.unitDetail || app.gui == nil {
+		return
+	}
+	vLogs, err := app.gui.View("logs")
+	if err != nil {
+		return
+	}
+	vServices, err := app.gui.View("services")
+	if err != nil {
+		return
+	}
+	var name string
+	_, cy := vServices.Cursor()
+	if line, err := vServices.Line(cy); err == nil {
+		name = serviceLineName(line)
+	}
+	x0, y0, x1, y1 := vLogs.Dimensions()
+	unitView, err := app.gui.SetView("unit", x0+(x1-x0)/2, y0, x1, y1, 0)
 	if err != nil && !errors.Is(err, gocui.ErrUnknownView) {
 		return
 	}
-	helpView.Title = " Help "
-	helpView.Autoscroll = true
-	helpView.Wrap = true
-	helpView.FrameColor = app.selectedFrameColor
-	helpView.TitleColor = app.selectedTitleColor
-	helpView.Clear()
-	fmt.Fprintln(helpView, "\n                   \033[32m_                              \033[36m_                                    _ ")
-	fmt.Fprintln(helpView, "                  \033[32m| |                            \033[36m| |                                  | |")
-	fmt.Fprintln(helpView, "                  \033[32m| |      __ _  ____ _   _      \033[36m| |  ___   _   _  _ __  _ __    __ _ | |")
-	fmt.Fprintln(helpView, "                  \033[32m| |     / _` ||_  /| | | | \033[36m_   | | / _ \\ | | | || '__|| '_ \\  / _` || |")
-	fmt.Fprintln(helpView, "                  \033[32m| |____| (_| | / / | |_| |\033[36m| |__| || (_) || |_| || |   | | | || (_| || |")
-	fmt.Fprintln(helpView, "                  \033[32m|______|\\__,_|/___| \\__, | \033[36m\\____/  \\___/  \\__,_||_|   |_| |_| \\__,_||_|")
-	fmt.Fprintln(helpView, "                  \033[32m					 __/ |                                             ")
-	fmt.Fprintln(helpView, "                  \033[32m                    |___/\033[0m")
-	fmt.Fprintln(helpView, "\n    Version: "+app.wordColor(appVersion))
-	fmt.Fprintln(helpView, "\n    Hotkeys description (default values):")
-	fmt.Fprintln(helpView, "\n      \033[32mF2\033[0m - interface for ssh manager and contexts switching.")
-	fmt.Fprintln(helpView, "      \033[32mTab\033[0m - switch to next window.")
-	fmt.Fprintln(helpView, "      \033[32mShift\033[0m+\033[32mTab\033[0m - return to previous window.")
-	fmt.Fprintln(helpView, "      \033[32mUp\033[0m/\033[32mPgUp\033[0m/\033[32mk\033[0m and \033[32mDown\033[0m/\033[32mPgDown\033[0m/\033[32mj\033[0m - move up and down through all journal lists and log output,")
-	fmt.Fprintln(helpView, "      as well as changing the filtering mode in the filter window.")
-	fmt.Fprintln(helpView, "      \033[32mShift\033[0m/\033[32mAlt\033[0m+\033[32mUp\033[0m/\033[32mDown\033[0m - quickly move up and down through all journal lists and log output")
-	fmt.Fprintln(helpView, "      every 10 or 100 lines (500 for log output).")
-	fmt.Fprintln(helpView, "      \033[32mShift\033[0m/\033[32mCtrl\033[0m+\033[32mk\033[0m/\033[32mj\033[0m - quickly move up and down (like Vim and alternative for macOS from config).")
-	fmt.Fprintln(helpView, "      \033[32mLeft\033[0m/\033[32mh\033[0m and \033[32mRight\033[0m/\033[32ml\033[0m - switch between journal lists in the selected window and change the date")
-	fmt.Fprintln(helpView, "      in the filter window.")
-	fmt.Fprintln(helpView, "      \033[32mDel\033[0m/\033[32mBackspace\033[0m - disable filtering by date.")
-	fmt.Fprintln(helpView, "      \033[32mEnter\033[0m - load a log from the list window or return to the previous window from the filter window.")
-	fmt.Fprintln(helpView, "      \033[32m/\033[0m - go to the filter window from the current list window or logs window.")
-	fmt.Fprintln(helpView, "      \033[32mEnd\033[0m/\033[32mCtrl\033[0m+\033[32mE\033[0m - go to the end of the log.")
-	fmt.Fprintln(helpView, "      \033[32mHome\033[0m/\033[32mCtrl\033[0m+\033[32mA\033[0m - go to the top of the log.")
-	fmt.Fprintln(helpView, "      \033[32m[\033[0m/\033[32m]\033[0m - change the number of log lines to output (range: 200-200000, default: 10K).")
-	fmt.Fprintln(helpView, "      \033[32m{\033[0m/\033[32m}\033[0m - change the update interval of the log output (range: 2-10, default: 5).")
-	fmt.Fprintln(helpView, "      \033[32mCtrl\033[0m+\033[32mU\033[0m - disable streaming of new events (log is loaded once without update).")
-	fmt.Fprintln(helpView, "      \033[32mCtrl\033[0m+\033[32mR\033[0m - update the current log output manually (relevant in disable streaming mode).")
-	fmt.Fprintln(helpView, "      \033[32mCtrl\033[0m+\033[32mQ\033[0m - update all log lists.")
-	fmt.Fprintln(helpView, "      \033[32mCtrl\033[0m+\033[32mW\033[0m - switch color mode between default, tailspin, bat or disable.")
-	fmt.Fprintln(helpView, "      \033[32mCtrl\033[0m+\033[32mD\033[0m - change read mode for docker logs (stream only or json from file system).")
-	fmt.Fprintln(helpView, "      \033[32mCtrl\033[0m+\033[32mS\033[0m - change stream display mode for docker logs (all, stdout or stderr only).")
-	fmt.Fprintln(helpView, "      \033[32mCtrl\033[0m+\033[32mT\033[0m - enable or disable built-in timestamp for Docker and Kubernetes logs.")
-	fmt.Fprintln(helpView, "      \033[32mCtrl\033[0m+\033[32mC\033[0m - clear input text in the filter window or exit.")
-	fmt.Fprintln(helpView, "\n    Source code: "+app.wordColor("https://github.com/Lifailon/lazyjournal"))
+	// Прокрутка колесом мыши (окно не получает фокус, чтобы не мешать перемещению по списку)
+	if errors.Is(err, gocui.ErrUnknownView) {
+		_ = app.gui.SetKeybinding("unit", gocui.MouseWheelUp, gocui.ModNone, func(g *gocui.Gui, v *gocui.View) error {
+			ox, oy := v.Origin()
+			if oy > 0 {
+				return v.SetOrigin(ox, oy-1)
+			}
+			return nil
+		})
+		_ = app.gui.SetKeybinding("unit", gocui.MouseWheelDown, gocui.ModNone, func(g *gocui.Gui, v *gocui.View) error {
+			ox, oy := v.Origin()
+			return v.SetOrigin(ox, oy+1)
+		})
+	}
+	unitView.Title = " Unit "
+	if name != "" && name != "_all" {
+		unitView.Title = " Unit: " + name + " "
+	}
+	unitView.Wrap = true
+	unitView.FrameColor = app.frameColor
+	unitView.TitleColor = app.titleColor
+	unitView.Clear()
+	_ = unitView.SetOrigin(0, 0)
+	fmt.Fprintln(unitView, "\033[33mLoading unit details...\033[0m")
+	// Информация загружается в горутине после паузы в перемещении курсора и выводится, только если курсор остался на том же юните
+	app.unitDetailSeq++
+	seq := app.unitDetailSeq
+	selectUnits := app.selectUnits
+	time.AfterFunc(unitDetailDelay, func() {
+		app.gui.Update(func(g *gocui.Gui) error {
+			if seq != app.unitDetailSeq || !app.unitDetail {
+				return nil
+			}
+			worker := app.loadWorker()
+			go func() {
+				lines := worker.unitDetailLines(selectUnits, name)
+				app.gui.Update(func(g *gocui.Gui) error {
+					if seq == app.unitDetailSeq && app.unitDetail {
+						app.showUnitDetailLines(lines)
+					}
+					return nil
+				})
+			}()
+			return nil
+		})
+	})
 }
 
-func (app *App) closeHelp(g *gocui.Gui) {
-	if err := g.DeleteView("help"); err != nil {
+// Задержка загрузки информации о юните после перемещения курсора (при быстром перемещении по списку команды не запускаются для каждого юнита)
+const unitDetailDelay = 200 * time.Millisecond
+
+// Функция для вывода загруженной информации о юните в панель
+func (app *App) showUnitDetailLines(lines []string) {
+	unitView, err := app.gui.View("unit")
+	if err != nil {
 		return
 	}
+	unitView.Clear()
+	_ = unitView.SetOrigin(0, 0)
+	for _, line := range lines {
+		fmt.Fprintln(unitView, line)
+	}
+}
+
+// Свойства юнита для панели информации (systemctl show)
+var unitDetailProperties = []string{
+	"Description",
+	"LoadState",
+	"ActiveState",
+	"SubState",
+	"UnitFileState",
+	"ActiveEnterTimestamp",
+	"MainPID",
+	"NRestarts",
+	"ExecMainStatus",
+	"MemoryCurrent",
+	"MemoryPeak",
+	"CPUUsageNSec",
+	"TasksCurrent",
+	"Triggers",
+	"TriggeredBy",
+	"NextElapseUSecRealtime",
+	"LastTriggerUSec",
+	"FragmentPath",
+	"DropInPaths",
+}
+
+// Функция для получения строк информации о юните: свойства через systemctl show и юнит-файл с drop-in через systemctl cat
+func (app *App) unitDetailLines(selectUnits string, name string) []string {
+	switch {
+	case selectUnits != "systemUnits" && selectUnits != "userUnits":
+		return []string{"\033[33mUnit details are available only for system and user units\033[0m"}
+	case name == "" || name == "_all":
+		return []string{"\033[33mSelect a unit to show details\033[0m"}
+	case app.journalPath != "":
+		return []string{"\033[33mUnit details are not available for offline journal\033[0m"}
+	}
+	var userArgs []string
+	if selectUnits == "userUnits" {
+		userArgs = []string{"--user"}
+	}
+	output, err := app.runCommand(
+		"Loading the unit properties",
+		"systemctl", slices.Concat(userArgs, []string{"show", name, "--no-pager", "--property=" + strings.Join(unitDetailProperties, ",")})...,
+	)
+	if err != nil {
+		return []string{"\033[31mError getting unit properties: " + err.Error() + "\033[0m"}
+	}
+	properties := make(map[string]string)
+	for line := range strings.SplitSeq(string(output), "\n") {
+		if key, value, found := strings.Cut(line, "="); found {
+			properties[key] = value
+		}
+	}
+	var lines []string
+	for _, key := range unitDetailProperties {
+		value := formatUnitProperty(key, properties[key])
+		if value != "" {
+			lines = append(lines, "\033[32m"+key+"\033[0m: "+value)
+		}
+	}
+	// Юнит-файл и drop-in файлы (названия файлов выводятся в комментариях)
+	output, err = app.runCommand("Loading the unit file", "systemctl", slices.Concat(userArgs, []string{"cat", name, "--no-pager"})...)
+	lines = append(lines, "")
+	if err != nil {
+		return append(lines, "\033[33mUnit file not found\033[0m")
+	}
+	for line := range strings.SplitSeq(strings.TrimRight(string(output), "\n"), "\n") {
+		if strings.HasPrefix(line, "# /") {
+			line = "\033[36m" + line + "\033[0m"
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// Функция для форматирования значения свойства юнита (размер памяти и время процессора в читаемом виде)
+func formatUnitProperty(key, value string) string {
+	// Неустановленное значение передается как максимальное значение uint64
+	if value == "18446744073709551615" || value == "[not set]" {
+		return "-"
+	}
+	number, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return value
+	}
+	switch key {
+	case "MemoryCurrent", "MemoryPeak":
+		return formatSize(number)
+	case "CPUUsageNSec":
+		return time.Duration(number).Round(time.Millisecond).String()
+	}
+	return value
+}
+
+// Функция для форматирования размера в читаемом виде (B, KB, MB, GB, TB)
+func formatSize(number uint64) string {
+	units := []string{"B", "KB", "MB", "GB", "TB"}
+	size := float64(number)
+	i := 0
+	for size >= 1024 && i < len(units)-1 {
+		size /= 1024
+		i++
+	}
+	if i == 0 {
+		return strconv.FormatUint(number, 10) + " B"
+	}
+	return strconv.FormatFloat(size, 'f', 1, 64) + " " + units[i]
 }
 
 // Интерфейс ошибки
@@ -9016,6 +12887,20 @@ func (app *App) closeInfo(g *gocui.Gui) {
 	}
 }
 
+// Функция для вывода окна ошибки на заданное время (окно создается и закрывается в основном потоке интерфейса)
+func (app *App) showInfo(errInfo bool, text string, duration time.Duration) {
+	app.gui.Update(func(g *gocui.Gui) error {
+		app.showInterfaceInfo(g, errInfo, text)
+		return nil
+	})
+	time.AfterFunc(duration, func() {
+		app.gui.Update(func(g *gocui.Gui) error {
+			app.closeInfo(g)
+			return nil
+		})
+	})
+}
+
 // Интерфейс менеджера (F2)
 func (app *App) showInterfaceManager(g *gocui.Gui) {
 	maxX, maxY := g.Size()
@@ -9192,12 +13077,7 @@ func (app *App) getSelectedLine(g *gocui.Gui, v *gocui.View) error {
 				app.sshStatus = "false"
 				app.getOS = runtime.GOOS
 				if !app.testMode {
-					go func() {
-						errorText := err.Error()
-						app.showInterfaceInfo(g, true, errorText)
-						time.Sleep(5 * time.Second)
-						app.closeInfo(g)
-					}()
+					app.showInfo(true, err.Error(), 5*time.Second)
 				}
 			} else {
 				app.getOS = getOS
@@ -9296,26 +13176,9 @@ func (app *App) nextViewManager(g *gocui.Gui, v *gocui.View, views []string) err
 
 // Функция для получения списка контекстов Docker
 func (app *App) getDockerContext() []string {
-	var cmd *exec.Cmd
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
-	if app.sshMode {
-		cmd = exec.CommandContext(
-			ctx,
-			"ssh", append(app.sshOptions,
-				"docker", "context", "ls", "-q",
-			)...)
-	} else {
-		cmd = exec.Command(
-			"docker", "context", "ls", "-q",
-		)
-	}
-	if app.logging {
-		slog.Info(cmd.String(), "action", "Loading the docker context list")
-	}
-	context, err := cmd.Output()
+	contexts, err := app.runCommandTimeout(5*time.Second, "Loading the docker context list", "docker", "context", "ls", "-q")
 	if err == nil {
-		strCtx := string(context)
+		strCtx := string(contexts)
 		return strings.Split(strCtx, "\n")
 	}
 	return nil
@@ -9323,26 +13186,9 @@ func (app *App) getDockerContext() []string {
 
 // Функция для получения списка контекстов Kubernetes
 func (app *App) getKubernetesContext() []string {
-	var cmd *exec.Cmd
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
-	if app.sshMode {
-		cmd = exec.CommandContext(
-			ctx,
-			"ssh", append(app.sshOptions,
-				"kubectl", "config", "get-contexts", "-o", "name",
-			)...)
-	} else {
-		cmd = exec.Command(
-			"kubectl", "config", "get-contexts", "-o", "name",
-		)
-	}
-	if app.logging {
-		slog.Info(cmd.String(), "action", "Loading the kubernetes context list")
-	}
-	context, err := cmd.Output()
+	contexts, err := app.runCommandTimeout(5*time.Second, "Loading the kubernetes context list", "kubectl", "config", "get-contexts", "-o", "name")
 	if err == nil {
-		strCtx := string(context)
+		strCtx := string(contexts)
 		return strings.Split(strCtx, "\n")
 	}
 	return nil
@@ -9350,24 +13196,7 @@ func (app *App) getKubernetesContext() []string {
 
 // Функция для получения списка контекстов Kubernetes
 func (app *App) getKubernetesNamespace() []string {
-	var cmd *exec.Cmd
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
-	if app.sshMode {
-		cmd = exec.CommandContext(
-			ctx,
-			"ssh", append(app.sshOptions,
-				"kubectl", "get", "namespace", "-o", "name",
-			)...)
-	} else {
-		cmd = exec.Command(
-			"kubectl", "get", "namespace", "-o", "name",
-		)
-	}
-	if app.logging {
-		slog.Info(cmd.String(), "action", "Loading the kubernetes namespace list")
-	}
-	namespace, err := cmd.Output()
+	namespace, err := app.runCommandTimeout(5*time.Second, "Loading the kubernetes namespace list", "kubectl", "get", "namespace", "-o", "name")
 	if err == nil {
 		strNs := string(namespace)
 		strNs = strings.ReplaceAll(strNs, "namespace/", "")
@@ -9519,6 +13348,7 @@ func (app *App) setFilterModeRight(g *gocui.Gui, v *gocui.View) error {
 	} else {
 		app.applyFilter(false)
 	}
+	app.scheduleJournalGrep()
 	return nil
 }
 
@@ -9581,6 +13411,7 @@ func (app *App) setFilterModeLeft(g *gocui.Gui, v *gocui.View) error {
 		selectedFilter.Title = "Filter (Default)"
 		app.selectFilterMode = "default"
 	}
+	app.scheduleJournalGrep()
 	return nil
 }
 
@@ -9591,33 +13422,39 @@ func (app *App) setUnitListRight(g *gocui.Gui, v *gocui.View) error {
 	if err != nil {
 		log.Panicln(err)
 	}
-	// Сбрасываем содержимое массива и положение курсора
+	// Сбрасываем содержимое массива, положение курсора и отметки журналов
 	app.journals = app.journals[:0]
 	app.startServices = 0
 	app.selectedJournal = 0
+	app.markedJournals = nil
 	// Меняем журнал и обновляем список
 	switch app.selectUnits {
 	case "systemUnits":
 		app.selectUnits = "userUnits"
 		selectedServices.Title = " < User units (0) > "
-		app.loadServices(app.selectUnits)
+		app.loadSelectedServices()
 	case "userUnits":
 		app.selectUnits = "systemJournals"
 		selectedServices.Title = " < System journals (0) > "
-		app.loadServices(app.selectUnits)
+		app.loadSelectedServices()
 	case "systemJournals":
 		app.selectUnits = "kernelBoot"
 		selectedServices.Title = " < Kernel boot (0) > "
-		app.loadServices(app.selectUnits)
+		app.loadSelectedServices()
 	case "kernelBoot":
 		app.selectUnits = "auditd"
-		selectedServices.Title = " < Audit rules keys (0) > "
-		app.loadServices(app.selectUnits)
+		selectedServices.Title = " < Audit events (0) > "
+		app.loadSelectedServices()
 	case "auditd":
+		app.selectUnits = "coredumps"
+		selectedServices.Title = " < Core dumps (0) > "
+		app.loadSelectedServices()
+	case "coredumps":
 		app.selectUnits = "systemUnits"
 		selectedServices.Title = " < System units (0) > "
-		app.loadServices(app.selectUnits)
+		app.loadSelectedServices()
 	}
+	app.updateUnitDetail()
 	return nil
 }
 
@@ -9629,31 +13466,56 @@ func (app *App) setUnitListLeft(g *gocui.Gui, v *gocui.View) error {
 	app.journals = app.journals[:0]
 	app.startServices = 0
 	app.selectedJournal = 0
+	app.markedJournals = nil
 	switch app.selectUnits {
 	case "systemUnits":
+		app.selectUnits = "coredumps"
+		selectedServices.Title = " < Core dumps (0) > "
+		app.loadSelectedServices()
+	case "coredumps":
 		app.selectUnits = "auditd"
-		selectedServices.Title = " < Audit rules keys (0) > "
-		app.loadServices(app.selectUnits)
+		selectedServices.Title = " < Audit events (0) > "
+		app.loadSelectedServices()
 	case "auditd":
 		app.selectUnits = "kernelBoot"
 		selectedServices.Title = " < Kernel boot (0) > "
-		app.loadServices(app.selectUnits)
+		app.loadSelectedServices()
 	case "kernelBoot":
 		app.selectUnits = "systemJournals"
 		selectedServices.Title = " < System journals (0) > "
-		app.loadServices(app.selectUnits)
+		app.loadSelectedServices()
 	case "systemJournals":
 		app.selectUnits = "userUnits"
 		selectedServices.Title = " < User units (0) > "
-		app.loadServices(app.selectUnits)
+		app.loadSelectedServices()
 	case "userUnits":
 		app.selectUnits = "systemUnits"
 		selectedServices.Title = " < System units (0) > "
-		app.loadServices(app.selectUnits)
+		app.loadSelectedServices()
 	}
+	app.updateUnitDetail()
 	return nil
 }
 
+// Функция для загрузки списка журналов в горутине на копии приложения (список выводится в основном потоке интерфейса)
+func (app *App) loadListBackground(load func(app *App)) {
+	worker := app.loadWorker()
+	go func() {
+		load(worker)
+		app.gui.Update(func(g *gocui.Gui) error {
+			app.applyPending(worker)
+			// Включаем переключение списков
+			if !app.keybindingsEnabled {
+				app.keybindingsEnabled = true
+				if err := app.setupKeybindings(); err != nil {
+					log.Panicln("Error key bindings", err)
+				}
+			}
+			return nil
+		})
+	}()
+}
+
 // Функция для переключения выбора журналов файловой системы
 func (app *App) setLogFilesListRight(g *gocui.Gui, v *gocui.View) error {
 	selectedVarLog, err := g.View("varLogs")
@@ -9677,67 +13539,40 @@ func (app *App) setLogFilesListRight(g *gocui.Gui, v *gocui.View) error {
 	app.logfiles = app.logfiles[:0]
 	app.startFiles = 0
 	app.selectedFile = 0
-	// Запускаем функцию загрузки журнала в горутине
+	// Запускаем функцию загрузки списка в горутине
 	if app.getOS == "windows" {
-		go func() {
-			switch app.selectPath {
-			case "ProgramFiles":
-				app.selectPath = "ProgramFiles86"
-				selectedVarLog.Title = " < Program Files x86 (0) > "
-				app.loadWinFiles(app.selectPath)
-			case "ProgramFiles86":
-				app.selectPath = "ProgramData"
-				selectedVarLog.Title = " < ProgramData (0) > "
-				app.loadWinFiles(app.selectPath)
-			case "ProgramData":
-				app.selectPath = "AppDataLocal"
-				selectedVarLog.Title = " < AppData Local (0) > "
-				app.loadWinFiles(app.selectPath)
-			case "AppDataLocal":
-				app.selectPath = "AppDataRoaming"
-				selectedVarLog.Title = " < AppData Roaming (0) > "
-				app.loadWinFiles(app.selectPath)
-			case "AppDataRoaming":
-				app.selectPath = "WinCustomPath"
-				selectedVarLog.Title = " < Custom Path (0) > "
-				app.loadWinFiles(app.selectPath)
-			case "WinCustomPath":
-				app.selectPath = "ProgramFiles"
-				selectedVarLog.Title = " < Program Files (0) > "
-				app.loadWinFiles(app.selectPath)
-			}
-			// Включаем переключение списков
-			app.keybindingsEnabled = true
-			if err := app.setupKeybindings(); err != nil {
-				log.Panicln("Error key bindings", err)
-			}
-		}()
+		switch app.selectPath {
+		case "ProgramFiles":
+			app.selectPath = "ProgramFiles86"
+			selectedVarLog.Title = " < Program Files x86 (0) > "
+		case "ProgramFiles86":
+			app.selectPath = "ProgramData"
+			selectedVarLog.Title = " < ProgramData (0) > "
+		case "ProgramData":
+			app.selectPath = "AppDataLocal"
+			selectedVarLog.Title = " < AppData Local (0) > "
+		case "AppDataLocal":
+			app.selectPath = "AppDataRoaming"
+			selectedVarLog.Title = " < AppData Roaming (0) > "
+		case "AppDataRoaming":
+			app.selectPath = "WinCustomPath"
+			selectedVarLog.Title = " < Custom Path (0) > "
+		case "WinCustomPath":
+			app.selectPath = "ProgramFiles"
+			selectedVarLog.Title = " < Program Files (0) > "
+		}
+		app.loadListBackground(func(app *App) {
+			app.loadWinFiles(app.selectPath)
+		})
 	} else {
-		go func() {
-			switch app.selectPath {
-			case "varlog":
-				app.selectPath = "customPath"
-				selectedVarLog.Title = " < Custom path - " + app.customPath + " (0) > "
-				app.loadFiles(app.selectPath)
-			case "customPath":
-				app.selectPath = "home"
-				selectedVarLog.Title = " < Users home logs (0) > "
-				app.loadFiles(app.selectPath)
-			case "home":
-				app.selectPath = "descriptor"
-				selectedVarLog.Title = " < Process descriptor logs (0) > "
-				app.loadFiles(app.selectPath)
-			case "descriptor":
-				app.selectPath = "varlog"
-				selectedVarLog.Title = " < System var logs (0) > "
-				app.loadFiles(app.selectPath)
-			}
-			// Включаем переключение списков
-			app.keybindingsEnabled = true
-			if err := app.setupKeybindings(); err != nil {
-				log.Panicln("Error key bindings", err)
-			}
-		}()
+		// Коллекции из конфигурации следуют в списке после пользовательского пути
+		lists := app.fileLogLists()
+		index := max(slices.Index(lists, app.selectPath), 0)
+		app.selectPath = lists[(index+1)%len(lists)]
+		selectedVarLog.Title = app.fileLogListTitle(app.selectPath)
+		app.loadListBackground(func(app *App) {
+			app.loadFiles(app.selectPath)
+		})
 	}
 	return nil
 }
@@ -9762,63 +13597,37 @@ func (app *App) setLogFilesListLeft(g *gocui.Gui, v *gocui.View) error {
 	app.startFiles = 0
 	app.selectedFile = 0
 	if app.getOS == "windows" {
-		go func() {
-			switch app.selectPath {
-			case "ProgramFiles":
-				app.selectPath = "WinCustomPath"
-				selectedVarLog.Title = " < Custom Path (0) > "
-				app.loadWinFiles(app.selectPath)
-			case "WinCustomPath":
-				app.selectPath = "AppDataRoaming"
-				selectedVarLog.Title = " < AppData Roaming (0) > "
-				app.loadWinFiles(app.selectPath)
-			case "AppDataRoaming":
-				app.selectPath = "AppDataLocal"
-				selectedVarLog.Title = " < AppData Local (0) > "
-				app.loadWinFiles(app.selectPath)
-			case "AppDataLocal":
-				app.selectPath = "ProgramData"
-				selectedVarLog.Title = " < ProgramData (0) > "
-				app.loadWinFiles(app.selectPath)
-			case "ProgramData":
-				app.selectPath = "ProgramFiles86"
-				selectedVarLog.Title = " < Program Files x86 (0) > "
-				app.loadWinFiles(app.selectPath)
-			case "ProgramFiles86":
-				app.selectPath = "ProgramFiles"
-				selectedVarLog.Title = " < Program Files (0) > "
-				app.loadWinFiles(app.selectPath)
-			}
-			app.keybindingsEnabled = true
-			if err := app.setupKeybindings(); err != nil {
-				log.Panicln("Error key bindings", err)
-			}
-		}()
+		switch app.selectPath {
+		case "ProgramFiles":
+			app.selectPath = "WinCustomPath"
+			selectedVarLog.Title = " < Custom Path (0) > "
+		case "WinCustomPath":
+			app.selectPath = "AppDataRoaming"
+			selectedVarLog.Title = " < AppData Roaming (0) > "
+		case "AppDataRoaming":
+			app.selectPath = "AppDataLocal"
+			selectedVarLog.Title = " < AppData Local (0) > "
+		case "AppDataLocal":
+			app.selectPath = "ProgramData"
+			selectedVarLog.Title = " < ProgramData (0) > "
+		case "ProgramData":
+			app.selectPath = "ProgramFiles86"
+			selectedVarLog.Title = " < Program Files x86 (0) > "
+		case "ProgramFiles86":
+			app.selectPath = "ProgramFiles"
+			selectedVarLog.Title = " < Program Files (0) > "
+		}
+		app.loadListBackground(func(app *App) {
+			app.loadWinFiles(app.selectPath)
+		})
 	} else {
-		go func() {
-			switch app.selectPath {
-			case "varlog":
-				app.selectPath = "descriptor"
-				selectedVarLog.Title = " < Process descriptor logs (0) > "
-				app.loadFiles(app.selectPath)
-			case "descriptor":
-				app.selectPath = "home"
-				selectedVarLog.Title = " < Users home logs (0) > "
-				app.loadFiles(app.selectPath)
-			case "home":
-				app.selectPath = "customPath"
-				selectedVarLog.Title = " < Custom path - " + app.customPath + " (0) > "
-				app.loadFiles(app.selectPath)
-			case "customPath":
-				app.selectPath = "varlog"
-				selectedVarLog.Title = " < System var logs (0) > "
-				app.loadFiles(app.selectPath)
-			}
-			app.keybindingsEnabled = true
-			if err := app.setupKeybindings(); err != nil {
-				log.Panicln("Error key bindings", err)
-			}
-		}()
+		lists := app.fileLogLists()
+		index := max(slices.Index(lists, app.selectPath), 0)
+		app.selectPath = lists[(index+len(lists)-1)%len(lists)]
+		selectedVarLog.Title = app.fileLogListTitle(app.selectPath)
+		app.loadListBackground(func(app *App) {
+			app.loadFiles(app.selectPath)
+		})
 	}
 	return nil
 }
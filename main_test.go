@@ -3,19 +3,32 @@ package main
 import (
 	"bufio"
 	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"errors"
 	"fmt"
+	"io"
 	"log"
 	"log/slog"
 	"os"
 	"os/exec"
 	"os/user"
+	"path/filepath"
 	"regexp"
 	"runtime"
+	"slices"
+	"sort"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/awesome-gocui/gocui"
+	"github.com/fsnotify/fsnotify"
+	"github.com/klauspost/compress/zstd"
+	"github.com/ulikunitz/xz"
+	"gopkg.in/yaml.v3"
 )
 
 func TestCreatReport(t *testing.T) {
@@ -103,14 +116,14 @@ func TestWinFiles(t *testing.T) {
 				// Фиксируем время запуска функции
 				startTime := time.Now()
 				// (2) Читаем журнал
-				app.loadFileLogs(strings.TrimSpace(logFileName), true)
+				app.loadFileLogs(context.Background(), strings.TrimSpace(logFileName), true)
 				endTime := time.Since(startTime)
 				// (3) Фильтруем и красим
 				startTime2 := time.Now()
 				app.applyFilter(true)
 				endTime2 := time.Since(startTime2)
 				// Записываем в отчет путь, количество строк в массиве прочитанных из файла, время чтения и фильтрации + покраски
-				fmt.Fprintf(file, "| %d | %s | %s | %s |\n", len(app.currentLogLines), endTime, endTime2, app.lastLogPath)
+				fmt.Fprintf(file, "| %d | %s | %s | %s |\n", len(app.currentLogRecords), endTime, endTime2, app.fileLogs.path)
 			}
 		})
 	}
@@ -156,14 +169,14 @@ func TestWinEvents(t *testing.T) {
 		app.updateFile = true
 		serviceName := ansiEscape.ReplaceAllString(journal.name, "")
 		startTime := time.Now()
-		app.loadJournalLogs(strings.TrimSpace(serviceName), true)
+		app.loadJournalLogs(context.Background(), strings.TrimSpace(serviceName), true)
 		endTime := time.Since(startTime)
 
 		startTime2 := time.Now()
 		app.applyFilter(true)
 		endTime2 := time.Since(startTime2)
 
-		fmt.Fprintf(file, "| %d | %s | %s | %s |\n", len(app.currentLogLines), endTime, endTime2, serviceName)
+		fmt.Fprintf(file, "| %d | %s | %s | %s |\n", len(app.currentLogRecords), endTime, endTime2, serviceName)
 	}
 }
 
@@ -224,14 +237,14 @@ func TestUnixFiles(t *testing.T) {
 			for _, logfile := range app.logfiles {
 				logFileName := ansiEscape.ReplaceAllString(logfile.name, "")
 				startTime := time.Now()
-				app.loadFileLogs(strings.TrimSpace(logFileName), true)
+				app.loadFileLogs(context.Background(), strings.TrimSpace(logFileName), true)
 				endTime := time.Since(startTime)
 
 				startTime2 := time.Now()
 				app.applyFilter(true)
 				endTime2 := time.Since(startTime2)
 
-				fmt.Fprintf(file, "| %d | %s | %s | %s |\n", len(app.currentLogLines), endTime, endTime2, app.lastLogPath)
+				fmt.Fprintf(file, "| %d | %s | %s | %s |\n", len(app.currentLogRecords), endTime, endTime2, app.fileLogs.path)
 			}
 		})
 	}
@@ -302,14 +315,14 @@ func TestLinuxJournal(t *testing.T) {
 			for _, journal := range app.journals {
 				serviceName := ansiEscape.ReplaceAllString(journal.name, "")
 				startTime := time.Now()
-				app.loadJournalLogs(strings.TrimSpace(serviceName), true)
+				app.loadJournalLogs(context.Background(), strings.TrimSpace(serviceName), true)
 				endTime := time.Since(startTime)
 
 				startTime2 := time.Now()
 				app.applyFilter(true)
 				endTime2 := time.Since(startTime2)
 
-				fmt.Fprintf(file, "| %d | %s | %s | %s |\n", len(app.currentLogLines), endTime, endTime2, serviceName)
+				fmt.Fprintf(file, "| %d | %s | %s | %s |\n", len(app.currentLogRecords), endTime, endTime2, serviceName)
 			}
 		})
 	}
@@ -352,7 +365,7 @@ func TestDockerContainer(t *testing.T) {
 				dateTimeRegex:                dateTimeRegex,
 				integersInputRegex:           integersInputRegex,
 				syslogUnitRegex:              syslogUnitRegex,
-				uniquePrefixColorMap:         make(map[string]string),
+				loadState:                    loadState{uniquePrefixColorMap: make(map[string]string)},
 			}
 
 			app.loadDockerContainer(app.selectContainerizationSystem)
@@ -366,19 +379,1512 @@ func TestDockerContainer(t *testing.T) {
 			for _, dockerContainer := range app.dockerContainers {
 				containerName := ansiEscape.ReplaceAllString(dockerContainer.name, "")
 				startTime := time.Now()
-				app.loadDockerLogs(strings.TrimSpace(containerName), true)
+				app.loadDockerLogs(context.Background(), strings.TrimSpace(containerName), true)
 				endTime := time.Since(startTime)
 
 				startTime2 := time.Now()
 				app.applyFilter(true)
 				endTime2 := time.Since(startTime2)
 
-				fmt.Fprintf(file, "| %d | %s | %s | %s |\n", len(app.currentLogLines), endTime, endTime2, containerName)
+				fmt.Fprintf(file, "| %d | %s | %s | %s |\n", len(app.currentLogRecords), endTime, endTime2, containerName)
 			}
 		})
 	}
 }
 
+// Исполнитель команд с заранее подготовленным выводом (ключ - префикс команды)
+type fakeExecutor struct {
+	outputs  map[string]string
+	handlers map[string]func(args []string) (string, error) // команды, вывод которых зависит от значений аргументов
+	commands []string
+}
+
+func (e *fakeExecutor) lookup(c Command) (string, error) {
+	line := strings.Join(append([]string{c.Name}, c.Args...), " ")
+	e.commands = append(e.commands, line)
+	for prefix, handler := range e.handlers {
+		if strings.HasPrefix(line, prefix) {
+			return handler(c.Args)
+		}
+	}
+	for prefix, output := range e.outputs {
+		if strings.HasPrefix(line, prefix) {
+			return output, nil
+		}
+	}
+	return "", &CommandError{Err: exec.ErrNotFound, Stderr: line}
+}
+
+func (e *fakeExecutor) Output(_ context.Context, c Command) ([]byte, error) {
+	output, err := e.lookup(c)
+	return []byte(output), err
+}
+
+func (e *fakeExecutor) Start(_ context.Context, c Command) (*Process, error) {
+	output, err := e.lookup(c)
+	if err != nil {
+		return nil, err
+	}
+	process := &Process{
+		Stdout: io.NopCloser(strings.NewReader(output)),
+		wait:   func() error { return nil },
+	}
+	if c.Stderr {
+		process.Stderr = io.NopCloser(strings.NewReader(""))
+	}
+	return process, nil
+}
+
+func TestFakeExecutor(t *testing.T) {
+	executor := &fakeExecutor{
+		outputs: map[string]string{
+			"journalctl --unit=cron.service": "Jan 01 10:00:00 host cron[1]: job started\nJan 01 10:01:00 host cron[1]: job finished\n",
+			"docker version":                 "Version: 28.0.0\n",
+			"docker --context default ps":    "a1b2c3 web running\nd4e5f6 db exited\n",
+			"docker --context default logs":  "2025-01-01T10:00:00.000000000Z web started\n2025-01-01T10:00:01.000000000Z web ready\n",
+			"kubectl version":                "Client Version: v1.31.0\n",
+			"kubectl get pods":               "u1 api-7d9f Running prod\nu2 migrate-x2 Failed jobs\n",
+			"kubectl logs":                   "[pod/api-7d9f/app] 2025-01-01T10:00:00.000000000Z api started\n[pod/api-7d9f/app] 2025-01-01T10:00:01.000000000Z api ready\n",
+		},
+	}
+	app := &App{
+		testMode:         true,
+		executor:         executor,
+		logViewCount:     "100",
+		getOS:            "linux",
+		selectUnits:      "systemUnits",
+		journalBoot:      "all",
+		journalPriority:  "debug",
+		dockerContext:    "default",
+		dockerStreamMode: "stdout",
+		loadState: loadState{
+			dockerStreamLogs:     true,
+			uniquePrefixColorMap: make(map[string]string),
+		},
+	}
+
+	app.loadJournalLogs(context.Background(), "cron.service", true)
+	if len(app.currentLogRecords) < 2 || app.currentLogRecords[1].message != "job finished" {
+		t.Errorf("Unexpected journal records: %+v", app.currentLogRecords)
+	}
+
+	app.selectContainerizationSystem = "docker"
+	app.loadDockerContainer("docker")
+	if len(app.dockerContainers) != 2 {
+		t.Fatalf("Expected 2 containers, got %d", len(app.dockerContainers))
+	}
+
+	app.loadDockerLogs(context.Background(), "web", true)
+	if len(app.currentLogRecords) != 2 || app.currentLogRecords[1].message != "web ready" || app.currentLogRecords[1].stream != "stdout" {
+		t.Errorf("Unexpected container records: %+v", app.currentLogRecords)
+	}
+	if last := executor.commands[len(executor.commands)-1]; !strings.HasSuffix(last, "a1b2c3") {
+		t.Errorf("Container logs requested by wrong id: %s", last)
+	}
+
+	app.selectContainerizationSystem = "kubernetes"
+	app.kubernetesContext = "default"
+	app.kubernetesNamespace = "--all-namespaces"
+	app.loadDockerContainer("kubernetes")
+	if len(app.dockerContainers) != 2 || app.dockerContainers[1].namespace != "prod" {
+		t.Fatalf("Unexpected pods: %+v", app.dockerContainers)
+	}
+	app.loadDockerLogs(context.Background(), "api-7d9f", true)
+	if len(app.currentLogRecords) != 2 || app.currentLogRecords[1].message != "api ready" || app.currentLogRecords[1].source != "api-7d9f/app" {
+		t.Errorf("Unexpected pod records: %+v", app.currentLogRecords)
+	}
+	if last := executor.commands[len(executor.commands)-1]; !strings.Contains(last, "-n prod") || !strings.HasSuffix(last, "api-7d9f") {
+		t.Errorf("Pod logs requested with wrong namespace or name: %s", last)
+	}
+}
+
+func TestFollowMode(t *testing.T) {
+	executor := &fakeExecutor{
+		outputs: map[string]string{
+			"journalctl --unit=cron.service": `{"__REALTIME_TIMESTAMP":"1735725600000000","_HOSTNAME":"host","SYSLOG_IDENTIFIER":"cron","_PID":"1","MESSAGE":"job started"}` + "\n" +
+				`{"__REALTIME_TIMESTAMP":"1735725660000000","_HOSTNAME":"host","SYSLOG_IDENTIFIER":"cron","MESSAGE":[106,111,98,10]}` + "\n",
+		},
+	}
+	app := &App{
+		testMode:        true,
+		executor:        executor,
+		logViewCount:    "3",
+		getOS:           "linux",
+		journalBoot:     "all",
+		journalPriority: "debug",
+		colorMode:       "disable",
+	}
+	app.journaldLogs = journaldSource{units: "systemUnits", name: "cron.service"}
+
+	var records []LogRecord
+	err := app.journaldLogs.Follow(context.Background(), app, func(record LogRecord) {
+		records = append(records, record)
+	})
+	if err != nil {
+		t.Fatalf("Follow journal error: %v", err)
+	}
+	lines := app.recordLines(records)
+	if len(lines) != 2 || !strings.HasSuffix(lines[0], " host cron[1]: job started") || !strings.HasSuffix(lines[1], " host cron: job") {
+		t.Errorf("Unexpected follow lines: %q", lines)
+	}
+	if last := executor.commands[len(executor.commands)-1]; !strings.Contains(last, "--lines=0 --follow --output=json") {
+		t.Errorf("Unexpected follow command: %s", last)
+	}
+	// Поток продолжает чтение после курсора последней загруженной записи
+	app.journaldLogs.cursor = "s=1;i=2"
+	if err := app.journaldLogs.Follow(context.Background(), app, func(record LogRecord) {}); err != nil {
+		t.Fatalf("Follow journal error: %v", err)
+	}
+	if last := executor.commands[len(executor.commands)-1]; !strings.Contains(last, "--after-cursor=s=1;i=2 --follow --output=json") || strings.Contains(last, "--lines=0") {
+		t.Errorf("Unexpected follow command after load: %s", last)
+	}
+
+	// Новые строки добавляются в конец вывода с ограничением по количеству строк
+	app.currentLogRecords = parseLogRecords([]string{"line 1", "line 2", ""})
+	app.filteredLogLines = []string{"line 1", "line 2", "", ""}
+	app.appendLogRecords(records)
+	if len(app.currentLogRecords) != 4 || app.currentLogRecords[0].message != "line 2" || app.currentLogRecords[3].text() != "" {
+		t.Errorf("Unexpected log records after append: %+v", app.currentLogRecords)
+	}
+	if len(app.filteredLogLines) != 5 || app.filteredLogLines[2] != lines[1] {
+		t.Errorf("Unexpected filtered lines after append: %q", app.filteredLogLines)
+	}
+
+	// Чтение новых строк из локального файла
+	path := t.TempDir() + "/follow.log"
+	if err := os.WriteFile(path, []byte("old line\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	// Строки, дописанные после загрузки и до запуска потока, читаются с позиции открытого при загрузке файла
+	tail, err := openFileTail(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tail.Close()
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _ = file.WriteString("missed line\n")
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	fileLines := make(chan string, 10)
+	go func() {
+		_ = followFile(ctx, tail, func(record LogRecord) {
+			fileLines <- record.text()
+		})
+	}()
+	time.Sleep(100 * time.Millisecond)
+	_, _ = file.WriteString("new line\npartial")
+	file.Close()
+	for _, expected := range []string{"missed line", "new line"} {
+		select {
+		case line := <-fileLines:
+			if line != expected {
+				t.Errorf("Unexpected file follow line: %q", line)
+			}
+		case <-time.After(2 * time.Second):
+			t.Errorf("Timeout waiting for %q in file", expected)
+		}
+	}
+	select {
+	case line := <-fileLines:
+		t.Errorf("Incomplete line should not be emitted: %q", line)
+	case <-time.After(2 * followFileInterval):
+	}
+}
+
+func TestFileTail(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "app.log")
+	os.WriteFile(path, []byte("line 1\nline 2\n"), 0o644)
+	app := &App{
+		testMode:     true,
+		logViewCount: "100",
+		getOS:        "linux",
+		colorMode:    "disable",
+		logfiles:     []Logfile{{name: "app.log", path: path}},
+	}
+	app.loadFileLogs(context.Background(), "app.log", true)
+	defer app.fileLogs.closeTail()
+	if app.fileLogs.tail == nil {
+		t.Fatal("Local file should stay open after loading")
+	}
+	lines := func() []string {
+		lines := app.recordLines(trimEmptyRecords(app.currentLogRecords))
+		for i, line := range lines {
+			lines[i] = removeANSI(line)
+		}
+		return lines
+	}
+	appendFile := func(path string, text string) {
+		file, _ := os.OpenFile(path, os.O_APPEND|os.O_WRONLY|os.O_CREATE, 0o644)
+		file.WriteString(text)
+		file.Close()
+	}
+	// Читаются только дописанные строки, неполная строка выводится после записи переноса
+	appendFile(path, "line 3\r\nline")
+	app.loadFileLogs(context.Background(), path, false)
+	appendFile(path, " 4\n")
+	app.loadFileLogs(context.Background(), path, false)
+	if expected := []string{"line 1", "line 2", "line 3", "line 4"}; !slices.Equal(lines(), expected) {
+		t.Errorf("Unexpected lines after append: %q", lines())
+	}
+	// При ротации старый файл дочитывается и открывается новый
+	os.Rename(path, path+".1")
+	appendFile(path+".1", "line 5\n")
+	appendFile(path, "new 1\n")
+	app.loadFileLogs(context.Background(), path, false)
+	expected := []string{"line 1", "line 2", "line 3", "line 4", "line 5", "⎯⎯⎯ " + path + " rotated ⎯⎯⎯", "new 1"}
+	if !slices.Equal(lines(), expected) {
+		t.Errorf("Unexpected lines after rotation: %q", lines())
+	}
+	// При усечении файл читается с начала
+	os.WriteFile(path, []byte("x\n"), 0o644)
+	app.loadFileLogs(context.Background(), path, false)
+	expected = append(expected, "⎯⎯⎯ "+path+" truncated ⎯⎯⎯", "x")
+	if !slices.Equal(lines(), expected) {
+		t.Errorf("Unexpected lines after truncation: %q", lines())
+	}
+	// Без изменений вывод не обновляется
+	app.loadFileLogs(context.Background(), path, false)
+	if len(lines()) != len(expected) {
+		t.Errorf("Unexpected lines without changes: %q", lines())
+	}
+	// Файл, открытый в отмененной загрузке копии приложения, закрывается, а файл основного потока остается открытым
+	worker := app.loadWorker()
+	worker.loadFileLogs(context.Background(), "app.log", true)
+	workerTail := worker.fileLogs.tail
+	if workerTail == nil || workerTail == app.fileLogs.tail {
+		t.Fatal("Worker should open its own file")
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	app.applyLoad(ctx, worker, &app.fileLogs)
+	if workerTail.file != nil || app.fileLogs.tail == nil || app.fileLogs.tail.file == nil {
+		t.Error("Unexpected open files after cancelled load")
+	}
+	// Примененная загрузка закрывает замененный файл
+	previousTail := app.fileLogs.tail
+	worker = app.loadWorker()
+	worker.loadFileLogs(context.Background(), "app.log", true)
+	app.applyLoad(context.Background(), worker, &app.fileLogs)
+	if previousTail.file != nil || app.fileLogs.tail != worker.fileLogs.tail {
+		t.Error("Replaced file should be closed after applied load")
+	}
+}
+
+func TestCancelLoad(t *testing.T) {
+	executor := &fakeExecutor{
+		outputs: map[string]string{
+			"journalctl --unit=cron.service": "Jan 01 10:00:00 host cron[1]: job started\n",
+		},
+	}
+	app := &App{
+		testMode:          true,
+		executor:          executor,
+		fastMode:          true,
+		logViewCount:      "100",
+		getOS:             "linux",
+		selectUnits:       "systemUnits",
+		journalBoot:       "all",
+		journalPriority:   "debug",
+		currentLogRecords: parseLogRecords([]string{"previous log"}),
+	}
+
+	// Результат отмененной загрузки не сохраняется
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	app.loadJournalLogs(ctx, "cron.service", true)
+	if len(app.currentLogRecords) != 1 || app.currentLogRecords[0].message != "previous log" {
+		t.Errorf("Canceled load overwrote log records: %+v", app.currentLogRecords)
+	}
+
+	// Новая загрузка отменяет предыдущую
+	started := make(chan struct{})
+	canceled := make(chan struct{})
+	app.runLoad(nil, func(ctx context.Context, app *App) {
+		close(started)
+		<-ctx.Done()
+		close(canceled)
+	})
+	<-started
+	if !app.loadInProgress() {
+		t.Error("Expected load in progress")
+	}
+	app.runLoad(nil, func(ctx context.Context, app *App) {})
+	select {
+	case <-canceled:
+	case <-time.After(2 * time.Second):
+		t.Error("Previous load was not canceled")
+	}
+	app.waitLoad()
+
+	// Загрузка в горутине выполняется на копии приложения, а результат применяется после ее завершения
+	app.runLoad(&app.journaldLogs, func(ctx context.Context, worker *App) {
+		if worker == app {
+			t.Error("Expected load on a copy of the application")
+		}
+		worker.loadJournalLogs(ctx, "cron.service", true)
+	})
+	app.waitLoad()
+	if app.loadInProgress() {
+		t.Error("Expected load to be finished")
+	}
+	if len(app.currentLogRecords) == 0 || app.currentLogRecords[0].text() != "Jan 01 10:00:00 host cron[1]: job started" {
+		t.Errorf("Background load result was not applied: %+v", app.currentLogRecords)
+	}
+	if app.journaldLogs.name != "cron.service" {
+		t.Errorf("Source state was not applied: %q", app.journaldLogs.name)
+	}
+}
+
+func TestLogRecords(t *testing.T) {
+	// Строки разбираются в поля и выводятся без изменений
+	lines := []string{
+		"Jan 01 10:00:00 host cron[1]: job started",
+		"2025-01-01T10:00:00.123456+03:00 host sshd[22]: level=error Connection closed",
+		"2025-01-01 10:00:00 plain message",
+		"-- Boot 1a2b --",
+	}
+	records := parseLogRecords(lines)
+	if records[0].format != syslogRecord || records[0].host != "host" || records[0].source != "cron" || records[0].fields["pid"] != "1" || records[0].message != "job started" {
+		t.Errorf("Unexpected syslog record: %+v", records[0])
+	}
+	if records[1].format != syslogRecord || records[1].level != "err" || records[1].timestamp.IsZero() {
+		t.Errorf("Unexpected iso syslog record: %+v", records[1])
+	}
+	if records[2].format != plainRecord || records[2].timestamp.IsZero() || records[2].message != "plain message" {
+		t.Errorf("Unexpected plain record: %+v", records[2])
+	}
+	app := &App{}
+	for i, line := range app.recordLines(records) {
+		if line != lines[i] {
+			t.Errorf("Record output %q, expected %q", line, lines[i])
+		}
+	}
+
+	// Записи compose сортируются и выводятся по полям
+	compose := []string{
+		"web  | 2025-01-01T10:00:02.000000000Z web ready",
+		"db   | 2025-01-01T10:00:01.000000000Z db started",
+	}
+	records = nil
+	for _, line := range compose {
+		record, ok := parseContainerRecord(line, "compose", false)
+		if !ok {
+			t.Fatalf("Timestamp not found: %q", line)
+		}
+		records = append(records, record)
+	}
+	sort.Slice(records, func(i, j int) bool { return records[i].timestamp.Before(records[j].timestamp) })
+	if records[0].source != "db" || records[0].stream != "" {
+		t.Errorf("Unexpected compose record: %+v", records[0])
+	}
+	if line := app.recordLine(records[1]); line != "[web] web ready" {
+		t.Errorf("Unexpected compose output: %q", line)
+	}
+	app.timestampDocker = true
+	if line := app.recordLine(records[1]); line != "[web] 2025-01-01T10:00:02.000000000Z web ready" {
+		t.Errorf("Unexpected compose output with timestamp: %q", line)
+	}
+	record, _ := parseContainerRecord("[pod/api-0/app] 2025-01-01T10:00:00Z ok", "kubectl", false)
+	if record.source != "api-0/app" || record.message != "ok" {
+		t.Errorf("Unexpected kubectl record: %+v", record)
+	}
+	app.streamTypeDocker = true
+	record, _ = parseContainerRecord("2025-01-01T10:00:00Z failed", "docker", true)
+	if line := app.recordLine(record); line != "stderr 2025-01-01T10:00:00Z failed" {
+		t.Errorf("Unexpected docker output: %q", line)
+	}
+
+	// Фильтр по тексту учитывает название источника, а фильтр по дате - время записи
+	app.filterText = "web"
+	filteredRecords, filteredLines, err := app.filterRecords(records)
+	if err != nil || len(filteredRecords) != 1 || filteredRecords[0].source != "web" || !strings.Contains(filteredLines[0], "\x1b[0;44mweb") {
+		t.Errorf("Unexpected filtered records: %+v %q", filteredRecords, filteredLines)
+	}
+	app.filterText = "db"
+	if filteredRecords, _, _ = app.filterRecords(records[1:]); len(filteredRecords) != 0 {
+		t.Errorf("Unexpected filtered records: %+v", filteredRecords)
+	}
+	dated := parseLogRecords([]string{
+		"2025-01-01T23:00:00Z old",
+		"2025-01-03T12:00:00Z new",
+		"no timestamp",
+	})
+	app.sinceDateFilterMode = true
+	app.sinceFilterText = "2025-01-03"
+	dated = app.filterRecordsByDate(dated)
+	if len(dated) != 2 || dated[0].message != "new" || dated[1].message != "no timestamp" {
+		t.Errorf("Unexpected records after date filter: %+v", dated)
+	}
+	app.untilDateFilterMode = true
+	app.untilFilterText = "2025-01-03"
+	if dated = app.filterRecordsByDate(dated); len(dated) != 1 {
+		t.Errorf("Unexpected records after until filter: %+v", dated)
+	}
+	// Для контейнеров начало дня определяется со смещением UTC из параметра --timezone-filter
+	app.untilDateFilterMode = false
+	app.timezoneFilter = "+02:00"
+	app.currentSource = &app.containerLogs
+	late := parseLogRecords([]string{"2025-01-02T21:30:00Z before", "2025-01-02T22:30:00Z after"})
+	if late = app.filterRecordsByDate(late); len(late) != 1 || late[0].message != "after" {
+		t.Errorf("Unexpected records after date filter with timezone: %+v", late)
+	}
+	// Для journald начало дня определяется в локальном времени, как в аргументе --since команды journalctl
+	local := time.Local
+	defer func() { time.Local = local }()
+	time.Local = time.FixedZone("UTC+2", 2*60*60)
+	app.timezoneFilter = "+05:00"
+	app.currentSource = &app.journaldLogs
+	late = parseLogRecords([]string{"2025-01-02T21:30:00Z before", "2025-01-02T22:30:00Z after"})
+	if late = app.filterRecordsByDate(late); len(late) != 1 || late[0].message != "after" {
+		t.Errorf("Unexpected records after date filter in local time: %+v", late)
+	}
+}
+
+func TestJournalJson(t *testing.T) {
+	executor := &fakeExecutor{
+		outputs: map[string]string{
+			"journalctl --unit=cron.service": `{"__REALTIME_TIMESTAMP":"1735725600000000","_HOSTNAME":"host","SYSLOG_IDENTIFIER":"cron","_PID":"1","PRIORITY":"3","CODE_FILE":"cron.c","MESSAGE":"job failed\nexit 1"}` + "\n",
+		},
+	}
+	app := &App{
+		testMode:        true,
+		executor:        executor,
+		logViewCount:    "100",
+		getOS:           "linux",
+		selectUnits:     "systemUnits",
+		journalBoot:     "all",
+		journalPriority: "debug",
+		journalJson:     true,
+	}
+	app.loadJournalLogs(context.Background(), "cron.service", true)
+	if last := executor.commands[len(executor.commands)-1]; !strings.HasSuffix(last, "--output=json") {
+		t.Errorf("Unexpected journal command: %s", last)
+	}
+	// Многострочное сообщение выводится как в формате short, а запись хранит все поля
+	lines := app.recordLines(app.currentLogRecords)
+	if len(lines) != 3 || !strings.HasSuffix(lines[0], " host cron[1]: job failed") || strings.TrimSpace(lines[1]) != "exit 1" || lines[2] != "" {
+		t.Fatalf("Unexpected journal lines: %q", lines)
+	}
+	record := app.currentLogRecords[0]
+	if record.level != "err" || record.fields["CODE_FILE"] != "cron.c" || record.fields["_PID"] != "1" {
+		t.Errorf("Unexpected journal record: %+v", record)
+	}
+	if fields := strings.Join(record.fieldLines(), "\n"); !strings.Contains(fields, "CODE_FILE\033[0m=cron.c") || !strings.Contains(fields, "level\033[0m=err") {
+		t.Errorf("Unexpected record fields: %s", fields)
+	}
+}
+
+func TestJournalCursor(t *testing.T) {
+	executor := &fakeExecutor{
+		outputs: map[string]string{
+			"journalctl --unit=cron.service": "Jan 01 10:00:00 host cron[1]: tick\nJan 01 10:00:00 host cron[1]: tick\n-- cursor: s=1\n",
+		},
+	}
+	app := &App{
+		testMode:        true,
+		executor:        executor,
+		logViewCount:    "100",
+		getOS:           "linux",
+		selectUnits:     "systemUnits",
+		journalBoot:     "all",
+		journalPriority: "debug",
+	}
+	app.loadJournalLogs(context.Background(), "cron.service", true)
+	if app.journaldLogs.cursor != "s=1" || len(app.currentLogRecords) != 3 {
+		t.Fatalf("Unexpected cursor %q and records %+v", app.journaldLogs.cursor, app.currentLogRecords)
+	}
+
+	// При обновлении читаются только записи после курсора (одинаковые строки не теряются)
+	executor.outputs["journalctl --unit=cron.service"] = "Jan 01 10:00:00 host cron[1]: tick\n-- cursor: s=2\n"
+	app.loadJournalLogs(context.Background(), "cron.service", false)
+	if last := executor.commands[len(executor.commands)-1]; !strings.HasSuffix(last, "--after-cursor=s=1 --show-cursor") {
+		t.Errorf("Unexpected refresh command: %s", last)
+	}
+	records := app.currentLogRecords
+	if app.journaldLogs.cursor != "s=2" || len(records) != 5 || records[2].format != delimiterRecord || records[3].message != "tick" {
+		t.Errorf("Unexpected records after refresh: %+v", records)
+	}
+
+	// Без новых записей вывод не изменяется
+	executor.outputs["journalctl --unit=cron.service"] = ""
+	app.loadJournalLogs(context.Background(), "cron.service", false)
+	if app.journaldLogs.cursor != "s=2" || len(app.currentLogRecords) != 5 {
+		t.Errorf("Unexpected records without new entries: %+v", app.currentLogRecords)
+	}
+
+	// При изменении фильтра журнал загружается полностью
+	app.journalPriority = "err"
+	executor.outputs["journalctl --unit=cron.service"] = "Jan 01 10:00:01 host cron[1]: failed\n-- cursor: s=3\n"
+	app.loadJournalLogs(context.Background(), "cron.service", false)
+	if last := executor.commands[len(executor.commands)-1]; strings.Contains(last, "--after-cursor") {
+		t.Errorf("Unexpected incremental command after filter change: %s", last)
+	}
+	if len(app.currentLogRecords) != 2 || app.currentLogRecords[0].message != "failed" {
+		t.Errorf("Unexpected records after filter change: %+v", app.currentLogRecords)
+	}
+}
+
+func TestJournalPath(t *testing.T) {
+	executor := &fakeExecutor{
+		outputs: map[string]string{
+			"journalctl --version": "systemd 255\n",
+			"journalctl --directory=/tmp/host/journal --no-pager --field=_SYSTEMD_USER_UNIT": "pipewire.service\napp.timer\npipewire.service\n",
+			"journalctl --directory=/tmp/host/journal --no-pager --field=_SYSTEMD_UNIT":      "sshd.service\ncron.service\nsession-1.scope\n",
+			"journalctl --directory=/tmp/host/journal --unit=sshd.service":                   "Jan 01 10:00:00 host sshd[1]: Accepted\n",
+		},
+	}
+	app := &App{
+		testMode:        true,
+		executor:        executor,
+		logViewCount:    "100",
+		getOS:           "linux",
+		unitType:        "service",
+		journalBoot:     "all",
+		journalPriority: "debug",
+		journalPath:     "/tmp/host/journal",
+	}
+
+	// Список юнитов строится из полей offline журнала без systemctl
+	app.loadServices("systemUnits")
+	var names []string
+	for _, journal := range app.journals {
+		names = append(names, journal.name)
+	}
+	if strings.Join(names, ",") != "_all,cron.service,sshd.service" {
+		t.Errorf("Unexpected offline units: %v", names)
+	}
+	app.loadServices("userUnits")
+	if len(app.journals) != 2 || app.journals[1].name != "pipewire.service" {
+		t.Errorf("Unexpected offline user units: %+v", app.journals)
+	}
+
+	// Пользовательские юниты фильтруются по полю без --user, так как журнал принадлежит другой системе
+	args := strings.Join(app.journalFilterArgs("userUnits", "pipewire.service"), " ")
+	if args != "--directory=/tmp/host/journal _SYSTEMD_USER_UNIT=pipewire.service + USER_UNIT=pipewire.service --boot=all --priority=debug" {
+		t.Errorf("Unexpected offline user unit args: %s", args)
+	}
+	args = strings.Join(app.journalFilterArgs("userUnits", "pipewire.service", "app.timer"), " ")
+	if args != "--directory=/tmp/host/journal _SYSTEMD_USER_UNIT=pipewire.service + USER_UNIT=pipewire.service + _SYSTEMD_USER_UNIT=app.timer + USER_UNIT=app.timer --boot=all --priority=debug" {
+		t.Errorf("Unexpected offline combined user unit args: %s", args)
+	}
+
+	app.selectUnits = "systemUnits"
+	app.loadJournalLogs(context.Background(), "sshd.service", true)
+	if last := executor.commands[len(executor.commands)-1]; !strings.HasPrefix(last, "journalctl --directory=/tmp/host/journal --unit=sshd.service") {
+		t.Errorf("Unexpected offline load command: %s", last)
+	}
+
+	app.journalPath = "/tmp/host/system.journal"
+	if args := app.journalSourceArgs(); len(args) != 1 || args[0] != "--file=/tmp/host/system.journal" {
+		t.Errorf("Unexpected journal file args: %v", args)
+	}
+}
+
+func TestJournalNamespace(t *testing.T) {
+	namespaces := parseJournalNamespaces([]string{
+		"0123456789abcdef0123456789abcdef",
+		"0123456789abcdef0123456789abcdef.web",
+		"0123456789abcdef0123456789abcdef.db",
+		"fedcba9876543210fedcba9876543210.web",
+		"remote",
+	})
+	if strings.Join(namespaces, ",") != "db,web" {
+		t.Errorf("Unexpected namespaces: %v", namespaces)
+	}
+
+	executor := &fakeExecutor{
+		outputs: map[string]string{
+			"journalctl --version": "systemd 255\n",
+			"journalctl --namespace=web --no-pager --field=_SYSTEMD_UNIT": "nginx.service\n",
+		},
+	}
+	app := &App{
+		testMode:         true,
+		executor:         executor,
+		getOS:            "linux",
+		unitType:         "service",
+		journalBoot:      "all",
+		journalPriority:  "debug",
+		journalNamespace: "web",
+	}
+
+	// Юниты пространства имен читаются из полей журнала, так как systemctl выводит все юниты системы
+	app.loadServices("systemUnits")
+	if len(app.journals) != 2 || app.journals[1].name != "nginx.service" {
+		t.Errorf("Unexpected namespace units: %+v", app.journals)
+	}
+	args := strings.Join(app.journalFilterArgs("userUnits", "app.service"), " ")
+	if args != "--namespace=web _SYSTEMD_USER_UNIT=app.service + USER_UNIT=app.service --boot=all --priority=debug" {
+		t.Errorf("Unexpected namespace user unit args: %s", args)
+	}
+}
+
+// Функция для выбора дампов как в coredumpctl info: совпадение всех полей FIELD=VALUE и время записи в журнал между --since и --until
+func coredumpInfoHandler(dumps []map[string]string) func(args []string) (string, error) {
+	return func(args []string) (string, error) {
+		var output []string
+		for _, dump := range dumps {
+			realtime, _ := strconv.ParseInt(dump["__REALTIME_TIMESTAMP"], 10, 64)
+			matched := true
+			for _, arg := range args {
+				if value, found := strings.CutPrefix(arg, "--since=@"); found {
+					since, _ := strconv.ParseInt(value, 10, 64)
+					matched = matched && realtime >= since*1e6
+				} else if value, found := strings.CutPrefix(arg, "--until=@"); found {
+					until, _ := strconv.ParseInt(value, 10, 64)
+					matched = matched && realtime <= until*1e6
+				} else if field, value, found := strings.Cut(arg, "="); found && !strings.HasPrefix(arg, "-") {
+					matched = matched && dump[field] == value
+				}
+			}
+			if matched {
+				output = append(output, "           PID: "+dump["COREDUMP_PID"]+" ("+dump["COREDUMP_COMM"]+")\n        Signal: "+dump["COREDUMP_SIGNAL"]+"\n")
+			}
+		}
+		if len(output) == 0 {
+			return "", &CommandError{Err: errors.New("exit status 1"), Stderr: "No coredumps found."}
+		}
+		return strings.Join(output, "\n"), nil
+	}
+}
+
+func TestCoredumps(t *testing.T) {
+	crashTime := time.Date(2026, 1, 2, 10, 0, 0, 0, time.Local)
+	// Время дампа (COREDUMP_TIMESTAMP) раньше времени записи в журнал, которое выводится в списке
+	dumps := []map[string]string{
+		{"COREDUMP_PID": "1234", "COREDUMP_COMM": "old", "COREDUMP_SIGNAL": "6 (ABRT)", "__REALTIME_TIMESTAMP": strconv.FormatInt(crashTime.Add(-time.Hour).UnixMicro(), 10)},
+		{"COREDUMP_PID": "1234", "COREDUMP_COMM": "nginx", "COREDUMP_SIGNAL": "11 (SEGV)", "COREDUMP_TIMESTAMP": strconv.FormatInt(crashTime.Add(-2*time.Second).UnixMicro(), 10), "__REALTIME_TIMESTAMP": strconv.FormatInt(crashTime.Add(250*time.Millisecond).UnixMicro(), 10)},
+		{"COREDUMP_PID": "99", "COREDUMP_COMM": "other", "COREDUMP_SIGNAL": "11 (SEGV)", "__REALTIME_TIMESTAMP": strconv.FormatInt(crashTime.Add(500*time.Millisecond).UnixMicro(), 10)},
+	}
+	executor := &fakeExecutor{
+		outputs: map[string]string{
+			"journalctl --version": "systemd 255\n",
+			"coredumpctl list": fmt.Sprintf(`[{"time":%d,"pid":1234,"uid":0,"gid":0,"sig":6,"corefile":"missing","exe":"/usr/bin/old","size":null},`+
+				`{"time":%d,"pid":1234,"uid":0,"gid":0,"sig":11,"corefile":"present","exe":"/usr/sbin/nginx","size":4096}]`,
+				crashTime.Add(-time.Hour).UnixMicro(), crashTime.Add(250*time.Millisecond).UnixMicro()),
+			"journalctl --since": "Jan 02 10:00:00 host nginx[1234]: worker crashed\n",
+		},
+		handlers: map[string]func(args []string) (string, error){
+			"coredumpctl info": coredumpInfoHandler(dumps),
+		},
+	}
+	app := &App{
+		testMode:        true,
+		executor:        executor,
+		logViewCount:    "100",
+		getOS:           "linux",
+		journalBoot:     "all",
+		journalPriority: "debug",
+	}
+
+	// Последний дамп выводится в начале списка
+	app.loadServices("coredumps")
+	if len(app.journals) != 2 {
+		t.Fatalf("Unexpected core dumps: %+v", app.journals)
+	}
+	name := removeANSI(app.journals[0].name)
+	if name != "02.01.2026 10:00:00 nginx[1234] SIGSEGV (present)" {
+		t.Errorf("Unexpected core dump name: %s", name)
+	}
+
+	app.selectUnits = "coredumps"
+	app.loadJournalLogs(context.Background(), name, true)
+	commands := strings.Join(executor.commands, "\n")
+	if !strings.Contains(commands, fmt.Sprintf("coredumpctl info --no-pager --since=@%d --until=@%d COREDUMP_PID=1234", crashTime.Unix(), crashTime.Unix()+1)) {
+		t.Errorf("Unexpected core dump info command: %s", commands)
+	}
+	if !strings.Contains(commands, fmt.Sprintf("journalctl --since=@%d --until=@%d", crashTime.Unix()-30, crashTime.Unix()+30)) {
+		t.Errorf("Unexpected core dump journal command: %s", commands)
+	}
+	var lines []string
+	for _, record := range app.currentLogRecords {
+		lines = append(lines, record.text())
+	}
+	// Выводится только выбранный дамп (дамп с тем же PID и дамп другого процесса в ту же секунду пропускаются)
+	if output := strings.Join(lines, "\n"); !strings.Contains(output, "PID: 1234 (nginx)") || strings.Contains(output, "(old)") || strings.Contains(output, "(other)") || !strings.Contains(output, "worker crashed") {
+		t.Errorf("Unexpected core dump output: %s", output)
+	}
+}
+
+func TestKernelBootDiff(t *testing.T) {
+	executor := &fakeExecutor{
+		outputs: map[string]string{
+			"journalctl -k -b old": "Linux version 6.1\n[    1.000001] usb 1-1: new device\nsystemd[1]: started\nfirmware: loaded iwlwifi-9000.ucode\n",
+			"journalctl -k -b new": "Linux version 6.1\n[    2.500000] usb 1-1: new device\nsystemd[245]: started\niwlwifi: failed to load firmware\n",
+		},
+	}
+	app := &App{
+		testMode:        true,
+		executor:        executor,
+		logViewCount:    "100",
+		getOS:           "linux",
+		selectUnits:     "kernelBoot",
+		journalBoot:     "all",
+		journalPriority: "debug",
+		journals: []Journal{
+			{name: "\033[34m02.01.2026 10:00:00\033[0m - \033[34m03.01.2026 10:00:00\033[0m", boot_id: "new"},
+			{name: "\033[34m01.01.2026 10:00:00\033[0m - \033[34m01.01.2026 12:00:00\033[0m", boot_id: "old"},
+		},
+	}
+	// Поздняя загрузка определяется по дате, а не по порядку отметки
+	app.markedJournals = []string{removeANSI(app.journals[0].name), removeANSI(app.journals[1].name)}
+	app.loadJournalLogs(context.Background(), removeANSI(app.journals[0].name), true)
+	var lines []string
+	for _, record := range trimEmptyRecords(app.currentLogRecords) {
+		lines = append(lines, removeANSI(record.text()))
+	}
+	output := strings.Join(lines, "\n")
+	for _, expected := range []string{"  [*] usb 1-1: new device", "  systemd[*]: started", "+ iwlwifi: failed to load firmware", "- firmware: loaded iwlwifi-9000.ucode", "Missing in the later boot: 1, new in the later boot: 1"} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("Expected %q in boot diff:\n%s", expected, output)
+		}
+	}
+	if strings.Index(output, "+ iwlwifi") > strings.Index(output, "- firmware") {
+		t.Errorf("Missing messages are not listed after the later boot:\n%s", output)
+	}
+
+	if err := app.journaldLogs.Follow(context.Background(), app, nil); !errors.Is(err, ErrFollowNotSupported) {
+		t.Errorf("Boot comparison should not be followed: %v", err)
+	}
+}
+
+func TestAuditLog(t *testing.T) {
+	dir := t.TempDir()
+	rotated := `type=USER_LOGIN msg=audit(1700000000.100:10): pid=900 uid=0 auid=4294967295 ses=4294967295 msg='op=login acct="admin" exe="/usr/sbin/sshd" hostname=? addr=10.0.0.5 terminal=sshd res=failed'` + "\n"
+	current := `type=SYSCALL msg=audit(1700000100.250:42): arch=c000003e syscall=59 success=yes exit=0 ppid=1 pid=1234 auid=1000 uid=0 comm="sudo" exe="/usr/bin/sudo" key="priv"` + "\n" +
+		`type=EXECVE msg=audit(1700000100.250:42): argc=2 a0="sudo" a1="id"` + "\n" +
+		`type=CWD msg=audit(1700000100.250:42): cwd=2F686F6D652F6D792075736572` + "\n" +
+		`type=PROCTITLE msg=audit(1700000100.250:42): proctitle=7375646F006964` + "\n"
+	os.WriteFile(dir+"/audit.log.1", []byte(rotated), 0o600)
+	os.WriteFile(dir+"/audit.log", []byte(current), 0o600)
+	app := &App{
+		testMode:     true,
+		logViewCount: "100",
+		getOS:        "linux",
+		selectUnits:  "auditd",
+		auditLogPath: dir + "/audit.log",
+	}
+
+	events, err := app.loadAuditEvents(context.Background())
+	if err != nil || len(events) != 2 {
+		t.Fatalf("Unexpected audit events: %+v %v", events, err)
+	}
+	// События из ротированного файла идут первыми, записи события объединяются по порядковому номеру
+	event := events[1]
+	if events[0].serial != "10" || event.serial != "42" || len(event.records) != 4 {
+		t.Errorf("Unexpected event grouping: %+v", events)
+	}
+	if event.field("syscall") != "execve" || event.field("arch") != "x86_64" || event.field("uid") != "root" {
+		t.Errorf("Fields are not interpreted: %+v", event.records[0].fields)
+	}
+	// Номера системных вызовов преобразуются в названия по таблице архитектуры (для неизвестной архитектуры остается номер)
+	for _, test := range []struct{ arch, syscall, expected string }{
+		{"c000003e", "159", "adjtimex"}, {"c000003e", "227", "clock_settime"}, {"c000003e", "304", "open_by_handle_at"},
+		{"c00000b7", "112", "clock_settime"}, {"40000003", "11", "execve"}, {"40000028", "11", "11"},
+	} {
+		fields := parseAuditFields("arch="+test.arch+" syscall="+test.syscall, nil)
+		if len(fields) != 2 || fields[1].value != test.expected {
+			t.Errorf("Unexpected syscall for arch %s: %+v", test.arch, fields)
+		}
+	}
+	if event.field("cwd") != "/home/my user" || event.field("proctitle") != "sudo id" {
+		t.Errorf("Hex fields are not decoded: cwd=%q proctitle=%q", event.field("cwd"), event.field("proctitle"))
+	}
+	if events[0].field("acct") != "admin" || events[0].field("auid") != "unset" || !events[0].failed() {
+		t.Errorf("Nested fields are not parsed: %+v", events[0].records[0].fields)
+	}
+
+	app.loadServices("auditd")
+	var names []string
+	for _, journal := range app.journals {
+		names = append(names, removeANSI(journal.name))
+	}
+	for _, expected := range []string{"[key] priv (1)", "[type] USER_LOGIN (1)", "[uid] root (2)", "[exe] /usr/bin/sudo (1)"} {
+		if !slices.Contains(names, expected) {
+			t.Errorf("Expected %q in audit list: %q", expected, names)
+		}
+	}
+
+	app.loadJournalLogs(context.Background(), "[exe] /usr/sbin/sshd (1)", true)
+	records := trimEmptyRecords(app.currentLogRecords)
+	if len(records) != 2 || !strings.Contains(records[0].message, "audit(1700000000.100:10) USER_LOGIN") || records[0].fields["USER_LOGIN.addr"] != "10.0.0.5" {
+		t.Errorf("Unexpected audit records: %+v", records)
+	}
+
+	// Дописанные строки добавляются к разобранным событиям без повторного чтения ротированных файлов
+	os.Remove(dir + "/audit.log.1")
+	file, _ := os.OpenFile(dir+"/audit.log", os.O_APPEND|os.O_WRONLY, 0o600)
+	file.WriteString(`type=SYSCALL msg=audit(1700000200.000:43): arch=c000003e syscall=59 success=yes exit=0 pid=1 uid=0 exe="/usr/bin/id"` + "\n" + `type=CWD msg=audit(1700000200.000:43): cwd="/"`)
+	events, err = app.loadAuditEvents(context.Background())
+	if err != nil || len(events) != 3 || len(events[2].records) != 1 {
+		t.Fatalf("Appended audit events are not parsed: %+v %v", events, err)
+	}
+	// Неполная строка разбирается после записи переноса и объединяется с событием
+	file.WriteString("\n")
+	file.Close()
+	events, _ = app.loadAuditEvents(context.Background())
+	if len(events) != 3 || len(events[2].records) != 2 || events[2].field("cwd") != "/" {
+		t.Errorf("Partial audit line is not merged: %+v", events)
+	}
+	// После ротации события читаются заново из всех файлов
+	os.Rename(dir+"/audit.log", dir+"/audit.log.1")
+	os.WriteFile(dir+"/audit.log", []byte(`type=USER_LOGIN msg=audit(1700000300.000:44): pid=1 uid=0 msg='op=login acct="root" res=success'`+"\n"), 0o600)
+	events, _ = app.loadAuditEvents(context.Background())
+	if len(events) != 3 || events[0].serial != "42" || events[2].serial != "44" {
+		t.Errorf("Audit log is not reloaded after rotation: %+v", events)
+	}
+}
+
+func TestAuditReports(t *testing.T) {
+	lines := []string{
+		`type=USER_LOGIN msg=audit(1700000000.100:10): pid=900 uid=0 auid=4294967295 ses=4294967295 msg='op=login acct="admin" exe="/usr/sbin/sshd" hostname=? addr=10.0.0.5 terminal=sshd res=failed'`,
+		`type=USER_LOGIN msg=audit(1700000001.100:11): pid=901 uid=0 auid=4294967295 ses=4294967295 msg='op=login acct="admin" exe="/usr/sbin/sshd" hostname=? addr=10.0.0.5 terminal=sshd res=failed'`,
+		`type=USER_AUTH msg=audit(1700000002.100:12): pid=902 uid=0 auid=1000 ses=3 msg='op=PAM:authentication grantors=pam_unix acct="bob" exe="/usr/bin/sudo" hostname=? addr=? terminal=/dev/pts/0 res=success'`,
+		`type=AVC msg=audit(1700000003.100:13): avc:  denied  { read write } for  pid=1500 comm="httpd" name="index.html" dev="sda1" ino=42 scontext=system_u:system_r:httpd_t:s0 tcontext=unconfined_u:object_r:user_home_t:s0 tclass=file permissive=0`,
+		`type=SYSCALL msg=audit(1700000003.100:13): arch=c000003e syscall=257 success=no exit=-13 pid=1500 uid=48 comm="httpd" exe="/usr/sbin/httpd" key=(null)`,
+		`type=AVC msg=audit(1700000004.100:14): apparmor="DENIED" operation="open" profile="/usr/sbin/cupsd" name="/etc/shadow" pid=700 comm="cupsd" requested_mask="r" denied_mask="r" fsuid=0 ouid=0`,
+		`type=ANOM_ABEND msg=audit(1700000005.100:15): auid=1000 uid=1000 gid=1000 ses=3 pid=1600 comm="crashy" exe="/opt/crashy" sig=11 res=1`,
+	}
+	events := parseAuditLog(lines, map[string]string{"0": "root"})
+	groups := auditEventGroups(events)
+	var names []string
+	for _, journal := range groups {
+		names = append(names, removeANSI(journal.name))
+	}
+	expected := []string{
+		"[failed-login] admin from 10.0.0.5 (2)",
+		"[auth] bob success from /dev/pts/0 (1)",
+		"[anomaly] ANOM_ABEND /opt/crashy (1)",
+		"[denial] system_u:system_r:httpd_t:s0 index.html {read write} (1)",
+		"[denial] /usr/sbin/cupsd /etc/shadow {r} (1)",
+		"[syscall] openat (1)",
+	}
+	for _, name := range expected {
+		if !slices.Contains(names, name) {
+			t.Errorf("Expected %q in audit summaries: %q", name, names)
+		}
+	}
+	// Сводки выводятся перед группами по полям
+	if !strings.HasPrefix(names[0], "[failed-login]") {
+		t.Errorf("Unexpected order of audit summaries: %q", names)
+	}
+	// Строка сводки открывает исходные события
+	for _, journal := range groups {
+		if removeANSI(journal.name) == "[failed-login] admin from 10.0.0.5 (2)" {
+			if drill := filterAuditEvents(events, journal.boot_id); len(drill) != 2 || drill[1].serial != "11" {
+				t.Errorf("Unexpected drill down events: %+v", drill)
+			}
+		}
+	}
+}
+
+func TestJournalMarks(t *testing.T) {
+	executor := &fakeExecutor{
+		outputs: map[string]string{
+			"journalctl --unit=nginx.service --unit=php-fpm.service": `{"__REALTIME_TIMESTAMP":"1700000000000000","_HOSTNAME":"host","SYSLOG_IDENTIFIER":"nginx","_PID":"10","MESSAGE":"GET /","_SYSTEMD_UNIT":"nginx.service","__CURSOR":"s=1"}` + "\n" +
+				`{"__REALTIME_TIMESTAMP":"1700000001000000","_HOSTNAME":"host","SYSLOG_IDENTIFIER":"systemd","_PID":"1","MESSAGE":"Reloaded\nphp-fpm","UNIT":"php-fpm.service","_SYSTEMD_UNIT":"init.scope","__CURSOR":"s=2"}` + "\n",
+		},
+	}
+	app := &App{
+		testMode:        true,
+		executor:        executor,
+		logViewCount:    "100",
+		getOS:           "linux",
+		selectUnits:     "systemUnits",
+		journalBoot:     "all",
+		journalPriority: "debug",
+		markedJournals:  []string{"nginx.service", "php-fpm.service"},
+	}
+	app.loadJournalLogs(context.Background(), "cron.service", true)
+	if last := executor.commands[len(executor.commands)-1]; !strings.Contains(last, "--output=json") {
+		t.Errorf("Merged journals are not read in JSON: %s", last)
+	}
+	lines := app.recordLines(trimEmptyRecords(app.currentLogRecords))
+	if len(lines) != 3 || !strings.HasPrefix(lines[0], "[nginx.service] ") || !strings.HasPrefix(lines[1], "[php-fpm.service] ") || !strings.HasPrefix(lines[2], "[php-fpm.service] ") {
+		t.Errorf("Unexpected merged lines: %q", lines)
+	}
+	if app.journaldLogs.cursor != "s=2" || app.journaldLogs.Describe() != "systemUnits/nginx.service+php-fpm.service" {
+		t.Errorf("Unexpected merged source state: %+v", app.journaldLogs)
+	}
+
+	// Журналы системы объединяются по нескольким значениям поля
+	app.journalField = "SYSLOG_IDENTIFIER"
+	args := strings.Join(app.journalFilterArgs("systemJournals", "sshd", "sudo"), " ")
+	if args != "SYSLOG_IDENTIFIER=sshd SYSLOG_IDENTIFIER=sudo --boot=all --priority=debug" {
+		t.Errorf("Unexpected merged field args: %s", args)
+	}
+}
+
+func TestJournalGrep(t *testing.T) {
+	executor := &fakeExecutor{
+		outputs: map[string]string{
+			"journalctl --unit=cron.service": "Jan 01 10:00:00 host cron[1]: job failed\n-- cursor: s=1\n",
+		},
+	}
+	app := &App{
+		testMode:         true,
+		executor:         executor,
+		logViewCount:     "100",
+		getOS:            "linux",
+		selectUnits:      "systemUnits",
+		journalBoot:      "all",
+		journalPriority:  "debug",
+		selectFilterMode: "regex",
+		filterText:       "fail(ed)?",
+	}
+	// Выражение передается в journalctl, поэтому ограничение строк применяется после поиска
+	app.loadJournalLogs(context.Background(), "cron.service", true)
+	if last := executor.commands[len(executor.commands)-1]; !strings.Contains(last, "--priority=debug --grep=fail(ed)? --case-sensitive=no --no-pager --lines=100") {
+		t.Errorf("Regex is not pushed down to journalctl: %s", last)
+	}
+	if app.journaldLogs.grep != "--grep=fail(ed)? --case-sensitive=no" {
+		t.Errorf("Unexpected grep state: %q", app.journaldLogs.grep)
+	}
+	// Выражение PCRE2 проверяет journalctl, загруженные записи повторно не фильтруются выражением Go
+	app.currentSource = &app.journaldLogs
+	app.filterText = "fail(?=ed)"
+	app.loadJournalLogs(context.Background(), "cron.service", true)
+	if last := executor.commands[len(executor.commands)-1]; !strings.Contains(last, "--grep=fail(?=ed) --case-sensitive=no") {
+		t.Errorf("PCRE2 regex is not pushed down to journalctl: %s", last)
+	}
+	records, lines, err := app.filterRecords(trimEmptyRecords(app.currentLogRecords))
+	if err != nil || len(records) != 1 || !strings.HasSuffix(lines[0], "job failed") {
+		t.Errorf("Records loaded with journalctl --grep are filtered again: %+v %q %v", records, lines, err)
+	}
+	// Ошибка выражения возвращается из journalctl
+	executor.handlers = map[string]func(args []string) (string, error){
+		"journalctl --unit=cron.service": func(args []string) (string, error) {
+			return "", &CommandError{Err: errors.New("exit status 1"), Stderr: "Bad pattern \"fail(\""}
+		},
+	}
+	app.filterText = "fail("
+	if _, err := app.readJournal(context.Background(), "Reading logs", app.journalFilterArgs("systemUnits", "cron.service"), true); err == nil || !strings.Contains(err.Error(), "Bad pattern") {
+		t.Errorf("Expected journalctl pattern error, got %v", err)
+	}
+	app.filterText = "failed"
+	app.selectFilterMode = "fuzzy"
+	if args := app.journalGrepArgs(); args != nil {
+		t.Errorf("Fuzzy filter is pushed down: %v", args)
+	}
+}
+
+func TestJournalMatches(t *testing.T) {
+	match := "_SYSTEMD_UNIT=nginx.service _PID=1234 + _CMDLINE=/usr/bin/app --debug"
+	if !isJournalMatch(match) || isJournalMatch("sshd") {
+		t.Fatalf("Unexpected match detection")
+	}
+	if terms := journalMatchTerms(match); len(terms) != 4 || terms[3] != "_CMDLINE=/usr/bin/app --debug" {
+		t.Errorf("Unexpected match terms: %q", terms)
+	}
+	// Значения с повторяющимися пробелами и условиями внутри записываются в кавычках
+	quotedTerms := []string{"MESSAGE=a  b FOO=c", "+", `_COMM="x"`, "_PID=1"}
+	quoted := journalMatchExpr(quotedTerms)
+	if quoted != `MESSAGE="a  b FOO=c" + _COMM="\"x\"" _PID=1` {
+		t.Errorf("Unexpected quoted match: %s", quoted)
+	}
+	if terms := journalMatchTerms(quoted); !slices.Equal(terms, quotedTerms) || !isJournalMatch(quoted) {
+		t.Errorf("Unexpected quoted match terms: %q", terms)
+	}
+	if !journalMatchRecord(match, map[string]string{"_SYSTEMD_UNIT": "nginx.service", "_PID": "1234"}) ||
+		journalMatchRecord(match, map[string]string{"_SYSTEMD_UNIT": "nginx.service", "_PID": "1"}) ||
+		!journalMatchRecord(match, map[string]string{"_CMDLINE": "/usr/bin/app --debug"}) {
+		t.Errorf("Unexpected match evaluation")
+	}
+
+	executor := &fakeExecutor{
+		outputs: map[string]string{
+			"journalctl --version":                            "systemd 255\n",
+			"journalctl --no-pager --field=SYSLOG_IDENTIFIER": "sshd\ncron\n",
+		},
+	}
+	app := &App{
+		testMode:        true,
+		executor:        executor,
+		journalField:    "SYSLOG_IDENTIFIER",
+		journalBoot:     "all",
+		journalPriority: "debug",
+		journalMatches:  []string{match},
+	}
+	// Сохраненное выражение выводится в списке системных журналов после _all
+	app.loadServices("systemJournals")
+	if len(app.journals) != 4 || app.journals[1].boot_id != match {
+		t.Errorf("Unexpected system journals: %+v", app.journals)
+	}
+	args := app.journalFilterArgs("systemJournals", removeStatusPrefix(removeANSI(app.journals[1].name)), "sshd")
+	if strings.Join(args, " ") != match+" + SYSLOG_IDENTIFIER=sshd --boot=all --priority=debug" {
+		t.Errorf("Unexpected match args: %q", args)
+	}
+	if args[3] != "_CMDLINE=/usr/bin/app --debug" {
+		t.Errorf("Value with spaces is split: %q", args)
+	}
+
+	// Выражения сохраняются в конфигурации без потери комментариев
+	configPath := t.TempDir() + "/config.yml"
+	os.WriteFile(configPath, []byte("settings:\n  # Comment\n  journalBoot: all\n  journalMatches: []\n"), 0644)
+	if err := saveJournalMatches(configPath, app.journalMatches); err != nil {
+		t.Fatal(err)
+	}
+	data, _ := os.ReadFile(configPath)
+	var saved Config
+	if err := yaml.Unmarshal(data, &saved); err != nil || !strings.Contains(string(data), "# Comment") ||
+		len(saved.Settings.JournalMatches) != 1 || saved.Settings.JournalMatches[0] != match {
+		t.Errorf("Unexpected saved config:\n%s", data)
+	}
+	// Пустой узел settings заменяется параметрами
+	os.WriteFile(configPath, []byte("# Comment\nsettings:\n"), 0644)
+	if err := saveJournalMatches(configPath, app.journalMatches); err != nil {
+		t.Fatal(err)
+	}
+	data, _ = os.ReadFile(configPath)
+	saved = Config{}
+	if err := yaml.Unmarshal(data, &saved); err != nil || len(saved.Settings.JournalMatches) != 1 || saved.Settings.JournalMatches[0] != match {
+		t.Errorf("Unexpected saved config with empty settings:\n%s", data)
+	}
+}
+
+func TestUnitDetail(t *testing.T) {
+	executor := &fakeExecutor{
+		outputs: map[string]string{
+			"systemctl show nginx.service": "ActiveState=active\nMainPID=1234\nNRestarts=2\nMemoryCurrent=5347737\nMemoryPeak=[not set]\nCPUUsageNSec=1500000000\nTriggeredBy=\n",
+			"systemctl cat nginx.service":  "# /lib/systemd/system/nginx.service\n[Service]\nExecStart=/usr/sbin/nginx\n\n# /etc/systemd/system/nginx.service.d/override.conf\n[Service]\nRestart=always\n",
+		},
+	}
+	app := &App{testMode: true, executor: executor}
+	lines := app.unitDetailLines("systemUnits", "nginx.service")
+	text := removeANSI(strings.Join(lines, "\n"))
+	for _, expected := range []string{"MainPID: 1234", "NRestarts: 2", "MemoryCurrent: 5.1 MB", "MemoryPeak: -", "CPUUsageNSec: 1.5s", "# /etc/systemd/system/nginx.service.d/override.conf", "Restart=always"} {
+		if !strings.Contains(text, expected) {
+			t.Errorf("Unit detail does not contain %q:\n%s", expected, text)
+		}
+	}
+	if strings.Contains(text, "TriggeredBy") {
+		t.Errorf("Empty properties should be skipped:\n%s", text)
+	}
+
+	// Для пользовательских юнитов команды выполняются с --user, для системных журналов информация недоступна
+	executor.outputs["systemctl --user show"] = "ActiveState=active\n"
+	app.unitDetailLines("userUnits", "pipewire.service")
+	if last := executor.commands[len(executor.commands)-2]; !strings.HasPrefix(last, "systemctl --user show pipewire.service") {
+		t.Errorf("Unexpected user unit command: %s", last)
+	}
+	if lines := app.unitDetailLines("systemJournals", "sshd"); len(lines) != 1 {
+		t.Errorf("Unexpected details for system journal: %v", lines)
+	}
+}
+
+func TestArchiveLogs(t *testing.T) {
+	content := "line 1\nline 2\nline 3\n"
+	var gzData, xzData, zstData bytes.Buffer
+	gzWriter := gzip.NewWriter(&gzData)
+	gzWriter.Write([]byte(content))
+	gzWriter.Close()
+	xzWriter, _ := xz.NewWriter(&xzData)
+	xzWriter.Write([]byte(content))
+	xzWriter.Close()
+	zstWriter, _ := zstd.NewWriter(&zstData)
+	zstWriter.Write([]byte(content))
+	zstWriter.Close()
+	// Стандартная библиотека не поддерживает сжатие bzip2
+	bz2Data, _ := base64.StdEncoding.DecodeString("QlpoOTFBWSZTWbzj7nwAAAfZAAAQQAA4AAIlIAAxBkxBHqDRpl4hDGcM8XckU4UJC84+58A=")
+	archives := map[string][]byte{
+		"syslog.2.gz":  gzData.Bytes(),
+		"syslog.3.xz":  xzData.Bytes(),
+		"syslog.4.bz2": bz2Data,
+		"syslog.5.zst": zstData.Bytes(),
+	}
+	dir := t.TempDir()
+	for name, data := range archives {
+		path := filepath.Join(dir, name)
+		os.WriteFile(path, data, 0o644)
+		for _, sshMode := range []bool{false, true} {
+			app := &App{
+				testMode:     true,
+				logViewCount: "2",
+				getOS:        "linux",
+				sshMode:      sshMode,
+				logfiles:     []Logfile{{name: name, path: path}},
+			}
+			// В режиме ssh сжатые данные передаются через cat и распаковываются локально
+			if sshMode {
+				app.executor = &fakeExecutor{outputs: map[string]string{
+					"stat":        fmt.Sprintf("%s|%d|1700000000\n", path, len(data)),
+					"cat " + path: string(data),
+				}}
+			}
+			app.loadFileLogs(context.Background(), name, true)
+			lines := app.recordLines(trimEmptyRecords(app.currentLogRecords))
+			if len(lines) != 2 || lines[0] != "line 2" || lines[1] != "line 3" {
+				t.Errorf("Unexpected lines from %s (ssh: %v): %q", name, sshMode, lines)
+			}
+		}
+	}
+	if _, err := (&App{}).openArchive(context.Background(), filepath.Join(dir, "missing.gz")); err == nil {
+		t.Error("Expected error for missing archive")
+	}
+}
+
+func TestReadLastLines(t *testing.T) {
+	// Строки длиннее блока чтения собираются из нескольких блоков
+	long := strings.Repeat("x", 3*reverseReadBlockSize+10)
+	testCases := []struct {
+		content  string
+		count    int
+		expected []string
+	}{
+		{"", 3, nil},
+		{"one\ntwo\nthree\n", 2, []string{"two", "three"}},
+		{"one\ntwo\nthree", 5, []string{"one", "two", "three"}},
+		{"one\r\ntwo\r\n", 1, []string{"two"}},
+		{"one\n\nthree\n", 3, []string{"one", "", "three"}},
+		{"first\n" + long + "\nlast\n", 2, []string{long, "last"}},
+		{long + "\r\n" + long, 3, []string{long, long}},
+	}
+	for _, testCase := range testCases {
+		lines, err := readLastLinesAt(strings.NewReader(testCase.content), int64(len(testCase.content)), testCase.count)
+		if err != nil || !slices.Equal(lines, testCase.expected) {
+			t.Errorf("Unexpected last lines for %.20q: %.60q (%v)", testCase.content, lines, err)
+		}
+		// Чтение из потока (распакованные архивы) возвращает те же строки
+		lines, err = readLastLines(strings.NewReader(testCase.content), testCase.count)
+		if err != nil || !slices.Equal(lines, testCase.expected) {
+			t.Errorf("Unexpected last lines from stream for %.20q: %.60q (%v)", testCase.content, lines, err)
+		}
+	}
+	// Локальный файл читается без запуска tail
+	path := filepath.Join(t.TempDir(), "app.log")
+	os.WriteFile(path, []byte("a\nb\nc\n"), 0o644)
+	app := &App{logViewCount: "2", executor: &fakeExecutor{}}
+	lines, err := app.tailFile(context.Background(), 0, "", path)
+	if err != nil || !slices.Equal(lines, []string{"b", "c"}) {
+		t.Errorf("Unexpected tail of local file: %q (%v)", lines, err)
+	}
+	if commands := app.executor.(*fakeExecutor).commands; len(commands) != 0 {
+		t.Errorf("Unexpected commands for local file: %q", commands)
+	}
+}
+
+func TestLogCollections(t *testing.T) {
+	dir := t.TempDir()
+	files := []string{
+		"srv/app1/logs/app.log",
+		"srv/app1/logs/app.debug.log",
+		"srv/app2/logs/2024/01/worker.log",
+		"srv/app2/logs/readme.txt",
+		"srv/app3/other/app.log",
+	}
+	for _, file := range files {
+		os.MkdirAll(filepath.Dir(filepath.Join(dir, file)), 0o755)
+		os.WriteFile(filepath.Join(dir, file), []byte("line\n"), 0o644)
+	}
+	os.MkdirAll(filepath.Join(dir, "srv/app4"), 0o755)
+	os.Symlink(filepath.Join(dir, "srv/app2/logs"), filepath.Join(dir, "srv/app4/logs"))
+	collection := LogCollection{
+		Name:    "srv",
+		Include: []string{dir + "/srv/*/logs/**/*.log"},
+		Exclude: []string{"*.debug.log"},
+	}
+	app := &App{
+		testMode:       true,
+		getOS:          "linux",
+		logCollections: []LogCollection{collection, {Name: "opt", Include: []string{"/opt"}}},
+	}
+	// Коллекции следуют в списке переключения после пользовательского пути
+	if lists := app.fileLogLists(); !slices.Equal(lists, []string{"varlog", "customPath", "collection:srv", "collection:opt", "home", "descriptor"}) {
+		t.Errorf("Unexpected file log lists: %q", lists)
+	}
+	if title := app.fileLogListTitle("collection:srv"); title != " < Collection - srv (0) > " {
+		t.Errorf("Unexpected collection title: %s", title)
+	}
+	loadNames := func() []string {
+		app.loadFiles("collection:srv")
+		var names []string
+		for _, logfile := range app.logfiles {
+			names = append(names, removeANSI(logfile.name))
+		}
+		slices.Sort(names)
+		return names
+	}
+	if names := loadNames(); !slices.Equal(names, []string{"app1 logs app", "app2 logs 2024 01 worker"}) {
+		t.Errorf("Unexpected collection files: %q", names)
+	}
+	// Переход по символическим ссылкам и ограничение глубины поиска
+	app.logCollections[0].FollowSymlinks = true
+	if names := loadNames(); !slices.Equal(names, []string{"app1 logs app", "app2 logs 2024 01 worker", "app4 logs 2024 01 worker"}) {
+		t.Errorf("Unexpected collection files with symlinks: %q", names)
+	}
+	app.logCollections[0].MaxDepth = 3
+	if names := loadNames(); !slices.Equal(names, []string{"app1 logs app"}) {
+		t.Errorf("Unexpected collection files with max depth: %q", names)
+	}
+	testCases := map[string]bool{
+		"/srv/a/logs/x.log":     true,
+		"/srv/a/logs/b/c/x.log": true,
+		"/srv/logs/x.log":       false,
+		"/srv/a/logs/x.txt":     false,
+	}
+	for name, expected := range testCases {
+		if matchLogGlob("/srv/*/logs/**/*.log", name) != expected {
+			t.Errorf("Unexpected glob match for %s", name)
+		}
+	}
+	if root := (LogCollection{Include: []string{"/srv/*/logs/*.log", "/srv/app/**"}}).root(); root != "/srv/" {
+		t.Errorf("Unexpected collection root: %s", root)
+	}
+}
+
+func TestFileListColumns(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]struct {
+		size int
+		age  time.Duration
+	}{
+		"syslog":   {size: 2048, age: 10 * time.Second},
+		"auth.log": {size: 100, age: 3 * time.Hour},
+		"kern.log": {size: 5 * 1024 * 1024, age: 45 * time.Minute},
+	}
+	var logfiles []Logfile
+	for name, file := range files {
+		path := filepath.Join(dir, name)
+		os.WriteFile(path, bytes.Repeat([]byte("x"), file.size), 0o644)
+		modTime := time.Now().Add(-file.age)
+		os.Chtimes(path, modTime, modTime)
+		fileInfo, _ := os.Stat(path)
+		logfiles = append(logfiles, newLogfile(strings.TrimSuffix(name, ".log"), path, fileInfo))
+	}
+	now := time.Now()
+	names := func() []string {
+		var names []string
+		for _, logfile := range logfiles {
+			names = append(names, removeANSI(logfile.listLine(now)))
+		}
+		return names
+	}
+	// По умолчанию новые файлы выше, файл с изменением за последнюю минуту отмечен как активный
+	sortLogFiles(logfiles, "")
+	expected := []string{"[ 10s   2.0 KB] ● syslog", "[ 45m   5.0 MB] kern", "[  3h    100 B] auth"}
+	if !slices.Equal(names(), expected) {
+		t.Errorf("Unexpected file list by mtime: %q", names())
+	}
+	sortLogFiles(logfiles, "name")
+	if logfiles[0].name != "auth" || logfiles[2].name != "syslog" {
+		t.Errorf("Unexpected file list by name: %q", names())
+	}
+	sortLogFiles(logfiles, "size")
+	if logfiles[0].name != "kern" || logfiles[2].name != "auth" {
+		t.Errorf("Unexpected file list by size: %q", names())
+	}
+	if age := formatAge(50 * 24 * time.Hour); age != "50d" {
+		t.Errorf("Unexpected age: %s", age)
+	}
+	// Возраст и отметка активного файла вычисляются при выводе списка
+	syslog := logfiles[slices.IndexFunc(logfiles, func(logfile Logfile) bool { return logfile.name == "syslog" })]
+	if line := removeANSI(syslog.listLine(now.Add(2 * time.Minute))); line != "[  2m   2.0 KB] syslog" {
+		t.Errorf("Unexpected file list line after two minutes: %q", line)
+	}
+	app := &App{testMode: true, logViewCount: "10", getOS: "linux", logfiles: logfiles}
+	app.loadFileLogs(context.Background(), "syslog", true)
+	defer app.fileLogs.closeTail()
+	if app.fileLogs.path != filepath.Join(dir, "syslog") {
+		t.Errorf("Unexpected selected file: %s", app.fileLogs.path)
+	}
+}
+
+func TestDirWatcher(t *testing.T) {
+	root := t.TempDir()
+	os.MkdirAll(filepath.Join(root, "nginx", "old"), 0o755)
+	fsWatcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		t.Skip("inotify is not available: ", err)
+	}
+	watcher := &dirWatcher{Watcher: fsWatcher, watched: make(map[string]bool)}
+	defer watcher.Close()
+	// Подкаталоги добавляются в наблюдение рекурсивно
+	if !watcher.addTree(root) || len(watcher.watched) != 3 || !watcher.watched[filepath.Join(root, "nginx", "old")] {
+		t.Fatalf("Unexpected watched directories: %v", watcher.watched)
+	}
+	waitEvent := func(name string) {
+		timeout := time.After(5 * time.Second)
+		for {
+			select {
+			case event := <-watcher.Events:
+				if event.Name == name && event.Has(fsnotify.Create) {
+					return
+				}
+			case <-timeout:
+				t.Fatalf("No create event for %s", name)
+			}
+		}
+	}
+	// Новый каталог добавляется в наблюдение, и создание файла в нем отслеживается
+	dir := filepath.Join(root, "app")
+	os.Mkdir(dir, 0o755)
+	waitEvent(dir)
+	watcher.addTree(dir)
+	os.WriteFile(filepath.Join(dir, "app.log"), nil, 0o644)
+	waitEvent(filepath.Join(dir, "app.log"))
+}
+
+func TestRefreshFileList(t *testing.T) {
+	logfiles := []Logfile{
+		{name: "nginx access", path: "/var/log/nginx/access.log"},
+		{name: "syslog", path: "/var/log/syslog"},
+		{name: "nginx error", path: "/var/log/nginx/error.log"},
+	}
+	app := &App{
+		testMode:          true,
+		filterListText:    "nginx",
+		logfilesNotFilter: logfiles,
+		logfiles:          []Logfile{logfiles[0], logfiles[2]},
+		selectedFile:      1,
+	}
+	// Новый файл добавляется в список с учетом фильтра, выбранный файл сохраняется
+	app.refreshFileList([]Logfile{
+		{name: "nginx app", path: "/var/log/nginx/app.log"},
+		logfiles[0],
+		logfiles[1],
+		logfiles[2],
+	})
+	if len(app.logfilesNotFilter) != 4 || len(app.logfiles) != 3 {
+		t.Errorf("Unexpected file lists after refresh: %d/%d", len(app.logfiles), len(app.logfilesNotFilter))
+	}
+	if app.logfiles[app.selectedFile].path != "/var/log/nginx/error.log" || app.startFiles != 1 {
+		t.Errorf("Unexpected selection after refresh: %d (start %d)", app.selectedFile, app.startFiles)
+	}
+	// При удалении выбранного файла курсор остается в пределах списка
+	app.refreshFileList([]Logfile{logfiles[0], logfiles[1]})
+	if len(app.logfiles) != 1 || app.selectedFile != 0 || app.startFiles != 0 {
+		t.Errorf("Unexpected selection after removing file: %d (start %d)", app.selectedFile, app.startFiles)
+	}
+}
+
+func TestLogFamilies(t *testing.T) {
+	logfiles := []Logfile{
+		{name: "syslog", path: "/var/log/syslog"},
+		{name: "syslog.1", path: "/var/log/syslog.1"},
+		{name: "auth.log", path: "/var/log/auth.log"},
+		{name: "syslog.3", path: "/var/log/syslog.3.gz"},
+		{name: "syslog.2", path: "/var/log/syslog.2.gz"},
+		{name: "nginx/access.log-20240102", path: "/var/log/nginx/access.log-20240102"},
+		{name: "nginx/access.log", path: "/var/log/nginx/access.log"},
+		{name: "nginx/access.log-20240101", path: "/var/log/nginx/access.log-20240101.gz"},
+		{name: "wtmp", path: "/var/log/wtmp"},
+		{name: "wtmp.1", path: "/var/log/wtmp.1"},
+		{name: "Xorg.0.log", path: "/var/log/Xorg.0.log"},
+		{name: "Xorg.1.log", path: "/var/log/Xorg.1.log"},
+		{name: "app/worker-1.log", path: "/var/log/app/worker-1.log"},
+		{name: "app/worker-2.log", path: "/var/log/app/worker-2.log"},
+	}
+	grouped := groupLogFamilies(logfiles)
+	names := make([]string, len(grouped))
+	for i, logfile := range grouped {
+		names[i] = removeANSI(logfile.name)
+	}
+	// Файлы с суффиксами без базового файла не объединяются
+	expected := []string{
+		"syslog (+3 rotated)", "auth.log", "nginx/access.log (+2 rotated)", "wtmp", "wtmp.1",
+		"Xorg.0.log", "Xorg.1.log", "app/worker-1.log", "app/worker-2.log",
+	}
+	if !slices.Equal(names, expected) {
+		t.Errorf("Unexpected grouped names: %q", names)
+	}
+	if family := grouped[0].family; !slices.Equal(family, []string{"/var/log/syslog.3.gz", "/var/log/syslog.2.gz", "/var/log/syslog.1"}) {
+		t.Errorf("Unexpected syslog family: %q", family)
+	}
+	if family := grouped[2].family; !slices.Equal(family, []string{"/var/log/nginx/access.log-20240101.gz", "/var/log/nginx/access.log-20240102"}) {
+		t.Errorf("Unexpected access.log family: %q", family)
+	}
+	// Ротированные файлы подгружаются перед текущим файлом по одному, начиная с самого нового
+	dir := t.TempDir()
+	var gzData bytes.Buffer
+	gzWriter := gzip.NewWriter(&gzData)
+	gzWriter.Write([]byte("old 1\nold 2\n"))
+	gzWriter.Close()
+	os.WriteFile(filepath.Join(dir, "app.log.2.gz"), gzData.Bytes(), 0o644)
+	os.WriteFile(filepath.Join(dir, "app.log.1"), []byte("prev 1\nprev 2\n"), 0o644)
+	os.WriteFile(filepath.Join(dir, "app.log"), []byte("new 1\nnew 2\n"), 0o644)
+	app := &App{
+		testMode:     true,
+		logViewCount: "10",
+		getOS:        "linux",
+		logfiles: groupLogFamilies([]Logfile{
+			{name: "app.log", path: filepath.Join(dir, "app.log")},
+			{name: "app.log.1", path: filepath.Join(dir, "app.log.1")},
+			{name: "app.log.2", path: filepath.Join(dir, "app.log.2.gz")},
+		}),
+	}
+	app.currentSource = &app.fileLogs
+	app.loadFileLogs(context.Background(), removeANSI(app.logfiles[0].name), true)
+	if app.fileLogs.Describe() != filepath.Join(dir, "app.log")+" (+2 rotated)" {
+		t.Errorf("Unexpected description: %s", app.fileLogs.Describe())
+	}
+	for range 2 {
+		if !app.loadFamilyMember() {
+			t.Fatal("Rotated file is not loaded")
+		}
+	}
+	if app.loadFamilyMember() {
+		t.Error("Load started without rotated files")
+	}
+	lines := app.recordLines(trimEmptyRecords(app.currentLogRecords))
+	for i, line := range lines {
+		lines[i] = removeANSI(line)
+	}
+	expected = []string{
+		"⎯⎯⎯ " + filepath.Join(dir, "app.log.2.gz") + " ⎯⎯⎯", "old 1", "old 2",
+		"⎯⎯⎯ " + filepath.Join(dir, "app.log.1") + " ⎯⎯⎯", "prev 1", "prev 2",
+		"⎯⎯⎯ " + filepath.Join(dir, "app.log") + " ⎯⎯⎯", "new 1", "new 2",
+	}
+	if !slices.Equal(lines, expected) {
+		t.Errorf("Unexpected stitched lines: %q", lines)
+	}
+	// При обновлении текущего файла загруженные ротированные файлы остаются в выводе
+	os.WriteFile(filepath.Join(dir, "app.log"), []byte("new 1\nnew 2\nnew 3\n"), 0o644)
+	app.lastSizeFile = 0
+	app.loadFileLogs(context.Background(), app.fileLogs.path, false)
+	lines = app.recordLines(trimEmptyRecords(app.currentLogRecords))
+	if len(lines) != len(expected)+1 || lines[1] != "old 1" || lines[len(lines)-1] != "new 3" {
+		t.Errorf("Unexpected lines after update: %q", lines)
+	}
+	// Дописанные строки не вытесняют загруженные ротированные файлы ограничением режима tail
+	app.appendLogRecords(parseLogRecords([]string{"new 4", "new 5", "new 6"}))
+	lines = app.recordLines(trimEmptyRecords(app.currentLogRecords))
+	if len(lines) != len(expected)+4 || lines[1] != "old 1" || lines[len(lines)-1] != "new 6" {
+		t.Errorf("Unexpected lines after append: %q", lines)
+	}
+
+	// В быстром режиме файл читается в горутине, записи добавляются при применении результата
+	app.fastMode = true
+	app.loadFileLogs(context.Background(), removeANSI(app.logfiles[0].name), true)
+	if !app.loadFamilyMember() {
+		t.Fatal("Rotated file is not loaded in the background")
+	}
+	app.waitLoad()
+	lines = app.recordLines(trimEmptyRecords(app.currentLogRecords))
+	if len(app.fileLogs.family) != 1 || len(lines) != 7 || removeANSI(lines[1]) != "prev 1" {
+		t.Errorf("Unexpected lines after background load: %q", lines)
+	}
+}
+
+func TestShellQuote(t *testing.T) {
+	testCases := map[string]string{
+		"--no-pager":             "--no-pager",
+		"--unit=cron.service":    "--unit=cron.service",
+		"%n|%s|%Y":               "'%n|%s|%Y'",
+		"{{.ID}} {{.Names}}":     "'{{.ID}} {{.Names}}'",
+		"it's":                   `'it'\''s'`,
+		"/var/log/app (old).log": "'/var/log/app (old).log'",
+	}
+	for arg, expected := range testCases {
+		if quoted := shellQuote(arg); quoted != expected {
+			t.Errorf("shellQuote(%q) = %s, expected %s", arg, quoted, expected)
+		}
+	}
+}
+
 func TestColor(t *testing.T) {
 	if runtime.GOOS == "windows" {
 		t.Skip("Skip unix test")
@@ -432,7 +1938,7 @@ func TestColor(t *testing.T) {
 	// Загружаем журнал
 	var ansiEscape = regexp.MustCompile(`\x1b\[[0-9;]*m`)
 	logFileName := ansiEscape.ReplaceAllString(app.logfiles[0].name, "")
-	app.loadFileLogs(strings.TrimSpace(logFileName), true)
+	app.loadFileLogs(context.Background(), strings.TrimSpace(logFileName), true)
 
 	// Выводим содержимое с покраской
 	app.applyFilter(true)
@@ -468,7 +1974,7 @@ func TestExtColor(t *testing.T) {
 
 	var ansiEscape = regexp.MustCompile(`\x1b\[[0-9;]*m`)
 	logFileName := ansiEscape.ReplaceAllString(app.logfiles[0].name, "")
-	app.loadFileLogs(strings.TrimSpace(logFileName), true)
+	app.loadFileLogs(context.Background(), strings.TrimSpace(logFileName), true)
 
 	app.applyFilter(true)
 	t.Log("Lines: ", len(app.filteredLogLines))
@@ -550,7 +2056,7 @@ func TestFilter(t *testing.T) {
 
 			var ansiEscape = regexp.MustCompile(`\x1b\[[0-9;]*m`)
 			logFileName := ansiEscape.ReplaceAllString(app.logfiles[0].name, "")
-			app.loadFileLogs(strings.TrimSpace(logFileName), true)
+			app.loadFileLogs(context.Background(), strings.TrimSpace(logFileName), true)
 
 			app.applyFilter(true)
 			t.Log("Lines: ", len(app.filteredLogLines))
@@ -600,7 +2106,7 @@ func TestCommandColor(t *testing.T) {
 		integersInputRegex:           integersInputRegex,
 		syslogUnitRegex:              syslogUnitRegex,
 		keybindingsEnabled:           true,
-		uniquePrefixColorMap:         make(map[string]string),
+		loadState:                    loadState{uniquePrefixColorMap: make(map[string]string)},
 	}
 
 	// Читаем содержимое тестируемого файла
@@ -683,7 +2189,7 @@ func TestCommandFuzzyFilter(t *testing.T) {
 		integersInputRegex:           integersInputRegex,
 		syslogUnitRegex:              syslogUnitRegex,
 		keybindingsEnabled:           true,
-		uniquePrefixColorMap:         make(map[string]string),
+		loadState:                    loadState{uniquePrefixColorMap: make(map[string]string)},
 	}
 
 	data, err := os.ReadFile("color.log")
@@ -729,7 +2235,7 @@ func TestCommandRegexFilter(t *testing.T) {
 		integersInputRegex:           integersInputRegex,
 		syslogUnitRegex:              syslogUnitRegex,
 		keybindingsEnabled:           true,
-		uniquePrefixColorMap:         make(map[string]string),
+		loadState:                    loadState{uniquePrefixColorMap: make(map[string]string)},
 	}
 
 	data, err := os.ReadFile("color.log")
@@ -785,7 +2291,7 @@ func TestMockInterface(t *testing.T) {
 		integersInputRegex:           integersInputRegex,
 		syslogUnitRegex:              syslogUnitRegex,
 		keybindingsEnabled:           true,
-		uniquePrefixColorMap:         make(map[string]string),
+		loadState:                    loadState{uniquePrefixColorMap: make(map[string]string)},
 	}
 
 	// Включаем логирование выполняемых команд в файл
@@ -864,9 +2370,9 @@ func TestMockInterface(t *testing.T) {
 			log.Panicln(err)
 		}
 		v.Title = " < Windows event logs (0) > "
-		go func() {
+		app.loadListBackground(func(app *App) {
 			app.loadWinEvents()
-		}()
+		})
 	} else {
 		app.loadServices(app.selectUnits)
 	}
@@ -889,9 +2395,9 @@ func TestMockInterface(t *testing.T) {
 		})
 		selectedVarLog.Title = " < Program Files (0) > "
 		app.selectPath = "ProgramFiles"
-		go func() {
+		app.loadListBackground(func(app *App) {
 			app.loadWinFiles(app.selectPath)
-		}()
+		})
 	} else {
 		app.loadFiles(app.selectPath)
 	}
@@ -921,8 +2427,8 @@ func TestMockInterface(t *testing.T) {
 	time.Sleep(3 * time.Second)
 
 	// Check help (F1)
-	app.showInterfaceHelp(g)
-	app.closeHelp(g)
+	runOnMainLoop(func() { app.showInterfaceHelp(g) })
+	runOnMainLoop(func() { app.closeHelp(g) })
 	if debug {
 		textLog := "test help interface (F1)"
 		t.Log(passLog + textLog)
@@ -930,8 +2436,8 @@ func TestMockInterface(t *testing.T) {
 	}
 
 	// Check ssh and context manager (F2)
-	app.showInterfaceManager(g)
-	app.closeManager(g)
+	runOnMainLoop(func() { app.showInterfaceManager(g) })
+	runOnMainLoop(func() { app.closeManager(g) })
 	if debug {
 		textLog := "test ssh and context manager interface (F2)"
 		t.Log(passLog + textLog)
@@ -939,21 +2445,23 @@ func TestMockInterface(t *testing.T) {
 	}
 
 	// Check highlighting (coloring)
-	app.currentLogLines = []string{
-		"http://127.0.0.1:8443",
-		"https://github.com/Lifailon/lazyjournal",
-		"/dev/null",
-		"root",
-		"warning",
-		"stderr",
-		"success",
-		"restart",
-		"0x04",
-		"2025-02-26T21:38:35.956968+03:00",
-		"127.0.0.1, 127.0.0.1:8443",
-	}
-	app.updateDelimiter(true)
-	app.applyFilter(true)
+	runOnMainLoop(func() {
+		app.currentLogRecords = parseLogRecords([]string{
+			"http://127.0.0.1:8443",
+			"https://github.com/Lifailon/lazyjournal",
+			"/dev/null",
+			"root",
+			"warning",
+			"stderr",
+			"success",
+			"restart",
+			"0x04",
+			"2025-02-26T21:38:35.956968+03:00",
+			"127.0.0.1, 127.0.0.1:8443",
+		})
+		app.updateDelimiter(true)
+		app.applyFilter(true)
+	})
 	time.Sleep(3 * time.Second)
 	if debug {
 		textLog := "test highlighting (coloring)"
@@ -961,8 +2469,25 @@ func TestMockInterface(t *testing.T) {
 		slog.Debug(textLog)
 	}
 
+	// Check field inspector (i)
+	runOnMainLoop(func() {
+		app.fieldsRecords = app.filteredLogRecords
+		app.fieldsIndex = 0
+		app.showInterfaceFields(g)
+		app.switchFieldsRecord(g, 1)
+		if app.fieldsIndex != 1 {
+			t.Errorf("Expected second record in field inspector, got %d", app.fieldsIndex)
+		}
+		app.closeFields(g)
+	})
+	if debug {
+		textLog := "test field inspector (i)"
+		t.Log(passLog + textLog)
+		slog.Debug(textLog)
+	}
+
 	// Обновить вывод лога
-	app.updateLogOutput(false)
+	runOnMainLoop(func() { app.updateLogOutput(false) })
 	if debug {
 		textLog := "update log (Ctrl+R)"
 		t.Log(passLog + textLog)
@@ -970,11 +2495,11 @@ func TestMockInterface(t *testing.T) {
 	}
 
 	// Проверяем фильтрацию текста для списков
-	app.filterListText = "a"
-	app.createFilterEditor("lists")
+	runOnMainLoop(func() { app.filterListText = "a" })
+	runOnMainLoop(func() { app.createFilterEditor("lists") })
 	time.Sleep(1 * time.Second)
 	// app.filterListText = ""
-	app.applyFilterList()
+	runOnMainLoop(func() { app.applyFilterList() })
 	time.Sleep(1 * time.Second)
 	if debug {
 		textLog := "test filter lists"
@@ -983,8 +2508,8 @@ func TestMockInterface(t *testing.T) {
 	}
 
 	// Очистка фильтров
-	app.clearFilterListEditor(g)
-	app.clearFilterEditor(g)
+	runOnMainLoop(func() { app.clearFilterListEditor(g) })
+	runOnMainLoop(func() { app.clearFilterEditor(g) })
 	if debug {
 		textLog := "clear filters before exit (Ctrl+C)"
 		t.Log(passLog + textLog)
@@ -992,8 +2517,8 @@ func TestMockInterface(t *testing.T) {
 	}
 
 	// Проверяем фильтрацию по timestamp
-	app.timestampFilterEditor("sinceFilter")
-	app.timestampFilterEditor("untilFilter")
+	runOnMainLoop(func() { app.timestampFilterEditor("sinceFilter") })
+	runOnMainLoop(func() { app.timestampFilterEditor("untilFilter") })
 	time.Sleep(1 * time.Second)
 	if debug {
 		textLog := "test filter timestamp"
@@ -1007,19 +2532,19 @@ func TestMockInterface(t *testing.T) {
 		t.Log(debugLog + textLog)
 		slog.Debug(textLog)
 	}
-	app.nextView(g, nil)
+	runOnMainLoop(func() { app.nextView(g, nil) })
 	time.Sleep(1 * time.Second)
 
 	// Journals (services)
-	if v, err := g.View("services"); err == nil {
+	if v, err := viewOnMainLoop("services"); err == nil {
 		// Перемещаемся по списку вниз
-		app.nextService(v, 100)
+		runOnMainLoop(func() { app.nextService(v, 100) })
 		time.Sleep(1 * time.Second)
 		// Загружаем журнал
-		app.selectService(g, v)
+		runOnMainLoop(func() { app.selectService(g, v) })
 		time.Sleep(3 * time.Second)
 		// Перемещаемся по списку вверх
-		app.prevService(v, 100)
+		runOnMainLoop(func() { app.prevService(v, 100) })
 		time.Sleep(1 * time.Second)
 		// Переключаем списки (только для Linux)
 		if runtime.GOOS != "windows" {
@@ -1029,35 +2554,35 @@ func TestMockInterface(t *testing.T) {
 				t.Log(debugLog + textLog)
 				slog.Debug(textLog)
 			}
-			app.setUnitListRight(g, v)
+			runOnMainLoop(func() { app.setUnitListRight(g, v) })
 			time.Sleep(3 * time.Second)
 			if debug {
 				textLog := "User units (UNIT)"
 				t.Log(passLog + textLog)
 				slog.Debug(textLog)
 			}
-			app.setUnitListRight(g, v)
+			runOnMainLoop(func() { app.setUnitListRight(g, v) })
 			time.Sleep(3 * time.Second)
 			if debug {
 				textLog := "System journals (USER_UNIT)"
 				t.Log(passLog + textLog)
 				slog.Debug(textLog)
 			}
-			app.setUnitListRight(g, v)
+			runOnMainLoop(func() { app.setUnitListRight(g, v) })
 			time.Sleep(3 * time.Second)
 			if debug {
 				textLog := "Kernel boot"
 				t.Log(passLog + textLog)
 				slog.Debug(textLog)
 			}
-			app.setUnitListRight(g, v)
+			runOnMainLoop(func() { app.setUnitListRight(g, v) })
 			time.Sleep(3 * time.Second)
 			if debug {
 				textLog := "Audit rules keys (auditd)"
 				t.Log(passLog + textLog)
 				slog.Debug(textLog)
 			}
-			app.setUnitListRight(g, v)
+			runOnMainLoop(func() { app.setUnitListRight(g, v) })
 			time.Sleep(3 * time.Second)
 			if debug {
 				textLog := "System units (services)"
@@ -1070,35 +2595,35 @@ func TestMockInterface(t *testing.T) {
 				t.Log(debugLog + textLog)
 				slog.Debug(textLog)
 			}
-			app.setUnitListLeft(g, v)
+			runOnMainLoop(func() { app.setUnitListLeft(g, v) })
 			time.Sleep(3 * time.Second)
 			if debug {
 				textLog := "Audit rules keys (auditd)"
 				t.Log(passLog + textLog)
 				slog.Debug(textLog)
 			}
-			app.setUnitListLeft(g, v)
+			runOnMainLoop(func() { app.setUnitListLeft(g, v) })
 			time.Sleep(3 * time.Second)
 			if debug {
 				textLog := "Kernel boot"
 				t.Log(passLog + textLog)
 				slog.Debug(textLog)
 			}
-			app.setUnitListLeft(g, v)
+			runOnMainLoop(func() { app.setUnitListLeft(g, v) })
 			time.Sleep(3 * time.Second)
 			if debug {
 				textLog := "System journals (USER_UNIT)"
 				t.Log(passLog + textLog)
 				slog.Debug(textLog)
 			}
-			app.setUnitListLeft(g, v)
+			runOnMainLoop(func() { app.setUnitListLeft(g, v) })
 			time.Sleep(3 * time.Second)
 			if debug {
 				textLog := "User units (UNIT)"
 				t.Log(passLog + textLog)
 				slog.Debug(textLog)
 			}
-			app.setUnitListLeft(g, v)
+			runOnMainLoop(func() { app.setUnitListLeft(g, v) })
 			time.Sleep(3 * time.Second)
 			if debug {
 				textLog := "System units (services)"
@@ -1119,19 +2644,19 @@ func TestMockInterface(t *testing.T) {
 		t.Log(debugLog + textLog)
 		slog.Debug(textLog)
 	}
-	app.nextView(g, nil)
+	runOnMainLoop(func() { app.nextView(g, nil) })
 	time.Sleep(1 * time.Second)
 
 	// File System (varLogs)
-	if v, err := g.View("varLogs"); err == nil {
+	if v, err := viewOnMainLoop("varLogs"); err == nil {
 		// Перемещаемся по списку вниз
-		app.nextFileName(v, 100)
+		runOnMainLoop(func() { app.nextFileName(v, 100) })
 		time.Sleep(1 * time.Second)
 		// Загружаем журнал
-		app.selectFile(g, v)
+		runOnMainLoop(func() { app.selectFile(g, v) })
 		time.Sleep(3 * time.Second)
 		// Перемещаемся по списку вверх
-		app.prevFileName(v, 100)
+		runOnMainLoop(func() { app.prevFileName(v, 100) })
 		time.Sleep(1 * time.Second)
 		if runtime.GOOS != "windows" {
 			// Вправо
@@ -1140,28 +2665,28 @@ func TestMockInterface(t *testing.T) {
 				t.Log(debugLog + textLog)
 				slog.Debug(textLog)
 			}
-			app.setLogFilesListRight(g, v)
+			runOnMainLoop(func() { app.setLogFilesListRight(g, v) })
 			time.Sleep(10 * time.Second)
 			if debug {
 				textLog := "Optional package logs and custom path"
 				t.Log(passLog + textLog)
 				slog.Debug(textLog)
 			}
-			app.setLogFilesListRight(g, v)
+			runOnMainLoop(func() { app.setLogFilesListRight(g, v) })
 			time.Sleep(10 * time.Second)
 			if debug {
 				textLog := "Users home logs"
 				t.Log(passLog + textLog)
 				slog.Debug(textLog)
 			}
-			app.setLogFilesListRight(g, v)
+			runOnMainLoop(func() { app.setLogFilesListRight(g, v) })
 			time.Sleep(10 * time.Second)
 			if debug {
 				textLog := "Process descriptor logs"
 				t.Log(passLog + textLog)
 				slog.Debug(textLog)
 			}
-			app.setLogFilesListRight(g, v)
+			runOnMainLoop(func() { app.setLogFilesListRight(g, v) })
 			time.Sleep(10 * time.Second)
 			if debug {
 				textLog := "System var logs"
@@ -1174,28 +2699,28 @@ func TestMockInterface(t *testing.T) {
 				t.Log(debugLog + textLog)
 				slog.Debug(textLog)
 			}
-			app.setLogFilesListLeft(g, v)
+			runOnMainLoop(func() { app.setLogFilesListLeft(g, v) })
 			time.Sleep(10 * time.Second)
 			if debug {
 				textLog := "Process descriptor logs"
 				t.Log(passLog + textLog)
 				slog.Debug(textLog)
 			}
-			app.setLogFilesListLeft(g, v)
+			runOnMainLoop(func() { app.setLogFilesListLeft(g, v) })
 			time.Sleep(10 * time.Second)
 			if debug {
 				textLog := "Users home logs"
 				t.Log(passLog + textLog)
 				slog.Debug(textLog)
 			}
-			app.setLogFilesListLeft(g, v)
+			runOnMainLoop(func() { app.setLogFilesListLeft(g, v) })
 			time.Sleep(10 * time.Second)
 			if debug {
 				textLog := "Optional package logs and custom path"
 				t.Log(passLog + textLog)
 				slog.Debug(textLog)
 			}
-			app.setLogFilesListLeft(g, v)
+			runOnMainLoop(func() { app.setLogFilesListLeft(g, v) })
 			time.Sleep(10 * time.Second)
 			if debug {
 				textLog := "System var logs"
@@ -1216,19 +2741,19 @@ func TestMockInterface(t *testing.T) {
 		t.Log(debugLog + textLog)
 		slog.Debug(textLog)
 	}
-	app.nextView(g, nil)
+	runOnMainLoop(func() { app.nextView(g, nil) })
 	time.Sleep(1 * time.Second)
 
 	// Containerization System (docker)
-	if v, err := g.View("docker"); err == nil {
+	if v, err := viewOnMainLoop("docker"); err == nil {
 		// Перемещаемся по списку вниз
-		app.nextDockerContainer(v, 100)
+		runOnMainLoop(func() { app.nextDockerContainer(v, 100) })
 		time.Sleep(1 * time.Second)
 		// Загружаем журнал (ВРЕМЕННО ОТКЛЮЧЕНО)
-		app.selectDocker(g, v)
+		runOnMainLoop(func() { app.selectDocker(g, v) })
 		time.Sleep(3 * time.Second)
 		// Перемещаемся по списку вверх
-		app.prevDockerContainer(v, 100)
+		runOnMainLoop(func() { app.prevDockerContainer(v, 100) })
 		time.Sleep(1 * time.Second)
 		if runtime.GOOS != "windows" {
 			// Вправо
@@ -1237,28 +2762,28 @@ func TestMockInterface(t *testing.T) {
 				t.Log(debugLog + textLog)
 				slog.Debug(textLog)
 			}
-			app.setContainersListRight(g, v)
+			runOnMainLoop(func() { app.setContainersListRight(g, v) })
 			time.Sleep(2 * time.Second)
 			if debug {
 				textLog := "Compose"
 				t.Log(passLog + textLog)
 				slog.Debug(textLog)
 			}
-			app.setContainersListRight(g, v)
+			runOnMainLoop(func() { app.setContainersListRight(g, v) })
 			time.Sleep(2 * time.Second)
 			if debug {
 				textLog := "Podman"
 				t.Log(passLog + textLog)
 				slog.Debug(textLog)
 			}
-			app.setContainersListRight(g, v)
+			runOnMainLoop(func() { app.setContainersListRight(g, v) })
 			time.Sleep(2 * time.Second)
 			if debug {
 				textLog := "Kubernetes"
 				t.Log(passLog + textLog)
 				slog.Debug(textLog)
 			}
-			app.setContainersListRight(g, v)
+			runOnMainLoop(func() { app.setContainersListRight(g, v) })
 			time.Sleep(2 * time.Second)
 			if debug {
 				textLog := "Docker"
@@ -1271,28 +2796,28 @@ func TestMockInterface(t *testing.T) {
 				t.Log(debugLog + textLog)
 				slog.Debug(textLog)
 			}
-			app.setContainersListLeft(g, v)
+			runOnMainLoop(func() { app.setContainersListLeft(g, v) })
 			time.Sleep(2 * time.Second)
 			if debug {
 				textLog := "Kubernetes"
 				t.Log(passLog + textLog)
 				slog.Debug(textLog)
 			}
-			app.setContainersListLeft(g, v)
+			runOnMainLoop(func() { app.setContainersListLeft(g, v) })
 			time.Sleep(2 * time.Second)
 			if debug {
 				textLog := "Podman"
 				t.Log(passLog + textLog)
 				slog.Debug(textLog)
 			}
-			app.setContainersListLeft(g, v)
+			runOnMainLoop(func() { app.setContainersListLeft(g, v) })
 			time.Sleep(2 * time.Second)
 			if debug {
 				textLog := "Compose"
 				t.Log(passLog + textLog)
 				slog.Debug(textLog)
 			}
-			app.setContainersListLeft(g, v)
+			runOnMainLoop(func() { app.setContainersListLeft(g, v) })
 			time.Sleep(2 * time.Second)
 			if debug {
 				textLog := "Docker"
@@ -1313,15 +2838,15 @@ func TestMockInterface(t *testing.T) {
 		t.Log(debugLog + textLog)
 		slog.Debug(textLog)
 	}
-	app.nextView(g, nil)
+	runOnMainLoop(func() { app.nextView(g, nil) })
 
 	// Проверяем фильтрацию текста для вывода журнала
-	app.filterText = "a"
-	app.applyFilter(true)
+	runOnMainLoop(func() { app.filterText = "a" })
+	runOnMainLoop(func() { app.applyFilter(true) })
 	time.Sleep(3 * time.Second)
 	// Ctrl+W
-	app.clearFilterEditor(g)
-	app.applyFilter(true)
+	runOnMainLoop(func() { app.clearFilterEditor(g) })
+	runOnMainLoop(func() { app.applyFilter(true) })
 	time.Sleep(3 * time.Second)
 	if debug {
 		textLog := "test filter logs output"
@@ -1330,35 +2855,35 @@ func TestMockInterface(t *testing.T) {
 	}
 
 	// Проверяем режимы фильтрации
-	if v, err := g.View("filter"); err == nil {
+	if v, err := viewOnMainLoop("filter"); err == nil {
 		// Вверх
 		if debug {
 			textLog := "Filter mode next (up)"
 			t.Log(debugLog + textLog)
 			slog.Debug(textLog)
 		}
-		app.setFilterModeRight(g, v)
+		runOnMainLoop(func() { app.setFilterModeRight(g, v) })
 		time.Sleep(1 * time.Second)
 		if debug {
 			textLog := "Filter fuzzy"
 			t.Log(passLog + textLog)
 			slog.Debug(textLog)
 		}
-		app.setFilterModeRight(g, v)
+		runOnMainLoop(func() { app.setFilterModeRight(g, v) })
 		time.Sleep(1 * time.Second)
 		if debug {
 			textLog := "Filter regex"
 			t.Log(passLog + textLog)
 			slog.Debug(textLog)
 		}
-		app.setFilterModeRight(g, v)
+		runOnMainLoop(func() { app.setFilterModeRight(g, v) })
 		time.Sleep(1 * time.Second)
 		if debug {
 			textLog := "Filter timestamp"
 			t.Log(passLog + textLog)
 			slog.Debug(textLog)
 		}
-		app.setFilterModeRight(g, v)
+		runOnMainLoop(func() { app.setFilterModeRight(g, v) })
 		time.Sleep(1 * time.Second)
 		if debug {
 			textLog := "Filter default"
@@ -1371,28 +2896,28 @@ func TestMockInterface(t *testing.T) {
 			t.Log(debugLog + textLog)
 			slog.Debug(textLog)
 		}
-		app.setFilterModeLeft(g, v)
+		runOnMainLoop(func() { app.setFilterModeLeft(g, v) })
 		time.Sleep(1 * time.Second)
 		if debug {
 			textLog := "Filter timestamp"
 			t.Log(passLog + textLog)
 			slog.Debug(textLog)
 		}
-		app.setFilterModeLeft(g, v)
+		runOnMainLoop(func() { app.setFilterModeLeft(g, v) })
 		time.Sleep(1 * time.Second)
 		if debug {
 			textLog := "Filter regex"
 			t.Log(passLog + textLog)
 			slog.Debug(textLog)
 		}
-		app.setFilterModeLeft(g, v)
+		runOnMainLoop(func() { app.setFilterModeLeft(g, v) })
 		time.Sleep(1 * time.Second)
 		if debug {
 			textLog := "Filter fuzzy"
 			t.Log(passLog + textLog)
 			slog.Debug(textLog)
 		}
-		app.setFilterModeLeft(g, v)
+		runOnMainLoop(func() { app.setFilterModeLeft(g, v) })
 		time.Sleep(1 * time.Second)
 		if debug {
 			textLog := "Filter default"
@@ -1412,60 +2937,60 @@ func TestMockInterface(t *testing.T) {
 		t.Log(debugLog + textLog)
 		slog.Debug(textLog)
 	}
-	app.nextView(g, nil)
+	runOnMainLoop(func() { app.nextView(g, nil) })
 	time.Sleep(1 * time.Second)
-	if v, err := g.View("logs"); err == nil {
+	if v, err := viewOnMainLoop("logs"); err == nil {
 		// Up tail
 		if debug {
 			textLog := "Up tail"
 			t.Log(debugLog + textLog)
 			slog.Debug(textLog)
 		}
-		app.setCountLogViewUp(g, v)
+		runOnMainLoop(func() { app.setCountLogViewUp(g, v) })
 		time.Sleep(1 * time.Second)
 		if debug {
 			textLog := "Tail 20K"
 			t.Log(passLog + textLog)
 			slog.Debug(textLog)
 		}
-		app.setCountLogViewUp(g, v)
+		runOnMainLoop(func() { app.setCountLogViewUp(g, v) })
 		time.Sleep(1 * time.Second)
 		if debug {
 			textLog := "Tail 30K"
 			t.Log(passLog + textLog)
 			slog.Debug(textLog)
 		}
-		app.setCountLogViewUp(g, v)
+		runOnMainLoop(func() { app.setCountLogViewUp(g, v) })
 		time.Sleep(1 * time.Second)
 		if debug {
 			textLog := "Tail 40K"
 			t.Log(passLog + textLog)
 			slog.Debug(textLog)
 		}
-		app.setCountLogViewUp(g, v)
+		runOnMainLoop(func() { app.setCountLogViewUp(g, v) })
 		time.Sleep(1 * time.Second)
 		if debug {
 			textLog := "Tail 50K"
 			t.Log(passLog + textLog)
 			slog.Debug(textLog)
 		}
-		app.setCountLogViewUp(g, v)
+		runOnMainLoop(func() { app.setCountLogViewUp(g, v) })
 		time.Sleep(1 * time.Second)
 		if debug {
 			textLog := "Tail 100K"
 			t.Log(passLog + textLog)
 			slog.Debug(textLog)
 		}
-		app.setCountLogViewUp(g, v)
+		runOnMainLoop(func() { app.setCountLogViewUp(g, v) })
 		time.Sleep(1 * time.Second)
 		if debug {
 			textLog := "Tail 150K"
 			t.Log(passLog + textLog)
 			slog.Debug(textLog)
 		}
-		app.setCountLogViewUp(g, v)
+		runOnMainLoop(func() { app.setCountLogViewUp(g, v) })
 		time.Sleep(1 * time.Second)
-		app.setCountLogViewUp(g, v)
+		runOnMainLoop(func() { app.setCountLogViewUp(g, v) })
 		time.Sleep(1 * time.Second)
 		if debug {
 			textLog := "Tail 200K"
@@ -1478,79 +3003,79 @@ func TestMockInterface(t *testing.T) {
 			t.Log(debugLog + textLog)
 			slog.Debug(textLog)
 		}
-		app.setCountLogViewDown(g, v)
+		runOnMainLoop(func() { app.setCountLogViewDown(g, v) })
 		time.Sleep(1 * time.Second)
 		if debug {
 			textLog := "Tail 150K"
 			t.Log(passLog + textLog)
 			slog.Debug(textLog)
 		}
-		app.setCountLogViewDown(g, v)
+		runOnMainLoop(func() { app.setCountLogViewDown(g, v) })
 		time.Sleep(1 * time.Second)
 		if debug {
 			textLog := "Tail 100K"
 			t.Log(passLog + textLog)
 			slog.Debug(textLog)
 		}
-		app.setCountLogViewDown(g, v)
+		runOnMainLoop(func() { app.setCountLogViewDown(g, v) })
 		time.Sleep(1 * time.Second)
 		if debug {
 			textLog := "Tail 50K"
 			t.Log(passLog + textLog)
 			slog.Debug(textLog)
 		}
-		app.setCountLogViewDown(g, v)
+		runOnMainLoop(func() { app.setCountLogViewDown(g, v) })
 		time.Sleep(1 * time.Second)
 		if debug {
 			textLog := "Tail 40K"
 			t.Log(passLog + textLog)
 			slog.Debug(textLog)
 		}
-		app.setCountLogViewDown(g, v)
+		runOnMainLoop(func() { app.setCountLogViewDown(g, v) })
 		time.Sleep(1 * time.Second)
 		if debug {
 			textLog := "Tail 30K"
 			t.Log(passLog + textLog)
 			slog.Debug(textLog)
 		}
-		app.setCountLogViewDown(g, v)
+		runOnMainLoop(func() { app.setCountLogViewDown(g, v) })
 		time.Sleep(1 * time.Second)
 		if debug {
 			textLog := "Tail 20K"
 			t.Log(passLog + textLog)
 			slog.Debug(textLog)
 		}
-		app.setCountLogViewDown(g, v)
+		runOnMainLoop(func() { app.setCountLogViewDown(g, v) })
 		time.Sleep(1 * time.Second)
 		if debug {
 			textLog := "Tail 10K (default)"
 			t.Log(passLog + textLog)
 			slog.Debug(textLog)
 		}
-		app.setCountLogViewDown(g, v)
+		runOnMainLoop(func() { app.setCountLogViewDown(g, v) })
 		time.Sleep(1 * time.Second)
 		if debug {
 			textLog := "Tail 5K"
 			t.Log(passLog + textLog)
 			slog.Debug(textLog)
 		}
-		app.setCountLogViewDown(g, v)
+		runOnMainLoop(func() { app.setCountLogViewDown(g, v) })
 		time.Sleep(1 * time.Second)
 		if debug {
 			textLog := "Tail 1K"
 			t.Log(passLog + textLog)
 			slog.Debug(textLog)
 		}
-		app.setCountLogViewDown(g, v)
+		runOnMainLoop(func() { app.setCountLogViewDown(g, v) })
 		time.Sleep(1 * time.Second)
 		if debug {
 			textLog := "Tail 500"
 			t.Log(passLog + textLog)
 			slog.Debug(textLog)
 		}
-		app.setCountLogViewDown(g, v)
+		runOnMainLoop(func() { app.setCountLogViewDown(g, v) })
 		time.Sleep(1 * time.Second)
-		app.setCountLogViewDown(g, v)
+		runOnMainLoop(func() { app.setCountLogViewDown(g, v) })
 		time.Sleep(1 * time.Second)
 		if debug {
 			textLog := "Tail 200"
@@ -1564,28 +3089,28 @@ func TestMockInterface(t *testing.T) {
 			t.Log(passLog + textLog)
 			slog.Debug(textLog)
 		}
-		app.setCountLogViewUp(g, v)
+		runOnMainLoop(func() { app.setCountLogViewUp(g, v) })
 		time.Sleep(1 * time.Second)
 		if debug {
 			textLog := "Tail 500"
 			t.Log(passLog + textLog)
 			slog.Debug(textLog)
 		}
-		app.setCountLogViewUp(g, v)
+		runOnMainLoop(func() { app.setCountLogViewUp(g, v) })
 		time.Sleep(1 * time.Second)
 		if debug {
 			textLog := "Tail 1K"
 			t.Log(passLog + textLog)
 			slog.Debug(textLog)
 		}
-		app.setCountLogViewUp(g, v)
+		runOnMainLoop(func() { app.setCountLogViewUp(g, v) })
 		time.Sleep(1 * time.Second)
 		if debug {
 			textLog := "Tail 5K"
 			t.Log(passLog + textLog)
 			slog.Debug(textLog)
 		}
-		app.setCountLogViewUp(g, v)
+		runOnMainLoop(func() { app.setCountLogViewUp(g, v) })
 		time.Sleep(1 * time.Second)
 		if debug {
 			textLog := "Tail 10K"
@@ -1598,7 +3123,7 @@ func TestMockInterface(t *testing.T) {
 			t.Log(passLog + textLog)
 			slog.Debug(textLog)
 		}
-		app.scrollUpLogs(1)
+		runOnMainLoop(func() { app.scrollUpLogs(1) })
 		time.Sleep(1 * time.Second)
 		// Up logs output on 10
 		if debug {
@@ -1606,7 +3131,7 @@ func TestMockInterface(t *testing.T) {
 			t.Log(passLog + textLog)
 			slog.Debug(textLog)
 		}
-		app.scrollUpLogs(10)
+		runOnMainLoop(func() { app.scrollUpLogs(10) })
 		time.Sleep(1 * time.Second)
 		// Up logs output on 500
 		if debug {
@@ -1614,9 +3139,9 @@ func TestMockInterface(t *testing.T) {
 			t.Log(passLog + textLog)
 			slog.Debug(textLog)
 		}
-		app.scrollUpLogs(500)
+		runOnMainLoop(func() { app.scrollUpLogs(500) })
 		time.Sleep(1 * time.Second)
-		app.scrollUpLogs(500)
+		runOnMainLoop(func() { app.scrollUpLogs(500) })
 		time.Sleep(1 * time.Second)
 		// Down logs output on 1
 		if debug {
@@ -1624,7 +3149,7 @@ func TestMockInterface(t *testing.T) {
 			t.Log(passLog + textLog)
 			slog.Debug(textLog)
 		}
-		app.scrollDownLogs(1)
+		runOnMainLoop(func() { app.scrollDownLogs(1) })
 		time.Sleep(1 * time.Second)
 		// Down logs output on 10
 		if debug {
@@ -1632,7 +3157,7 @@ func TestMockInterface(t *testing.T) {
 			t.Log(passLog + textLog)
 			slog.Debug(textLog)
 		}
-		app.scrollDownLogs(10)
+		runOnMainLoop(func() { app.scrollDownLogs(10) })
 		time.Sleep(1 * time.Second)
 		// Down logs output on 500
 		if debug {
@@ -1640,7 +3165,7 @@ func TestMockInterface(t *testing.T) {
 			t.Log(passLog + textLog)
 			slog.Debug(textLog)
 		}
-		app.scrollDownLogs(500)
+		runOnMainLoop(func() { app.scrollDownLogs(500) })
 		time.Sleep(1 * time.Second)
 		// Move log output to top
 		if debug {
@@ -1648,7 +3173,7 @@ func TestMockInterface(t *testing.T) {
 			t.Log(passLog + textLog)
 			slog.Debug(textLog)
 		}
-		app.pageUpLogs()
+		runOnMainLoop(func() { app.pageUpLogs() })
 		time.Sleep(1 * time.Second)
 		// Move log output to down
 		if debug {
@@ -1656,7 +3181,7 @@ func TestMockInterface(t *testing.T) {
 			t.Log(passLog + textLog)
 			slog.Debug(textLog)
 		}
-		app.updateLogsView(true)
+		runOnMainLoop(func() { app.updateLogsView(true) })
 		time.Sleep(1 * time.Second)
 	}
 	if debug {
@@ -1666,23 +3191,23 @@ func TestMockInterface(t *testing.T) {
 	}
 
 	// TAB filter lists
-	app.nextView(g, nil)
+	runOnMainLoop(func() { app.nextView(g, nil) })
 	time.Sleep(1 * time.Second)
 
 	// Back Tab
-	app.backView(g, nil)
+	runOnMainLoop(func() { app.backView(g, nil) })
 	time.Sleep(1 * time.Second)
-	app.backView(g, nil)
+	runOnMainLoop(func() { app.backView(g, nil) })
 	time.Sleep(1 * time.Second)
-	app.backView(g, nil)
+	runOnMainLoop(func() { app.backView(g, nil) })
 	time.Sleep(1 * time.Second)
-	app.backView(g, nil)
+	runOnMainLoop(func() { app.backView(g, nil) })
 	time.Sleep(1 * time.Second)
-	app.backView(g, nil)
+	runOnMainLoop(func() { app.backView(g, nil) })
 	time.Sleep(1 * time.Second)
-	app.backView(g, nil)
+	runOnMainLoop(func() { app.backView(g, nil) })
 	time.Sleep(1 * time.Second)
-	app.backView(g, nil)
+	runOnMainLoop(func() { app.backView(g, nil) })
 	time.Sleep(1 * time.Second)
 	if debug {
 		textLog := "test back tab (Shift+Tab)"
@@ -1691,17 +3216,17 @@ func TestMockInterface(t *testing.T) {
 	}
 
 	// Проверяем переключение окон с помощью мыши
-	app.setSelectView(g, "filterList")
+	runOnMainLoop(func() { app.setSelectView(g, "filterList") })
 	time.Sleep(1 * time.Second)
-	app.setSelectView(g, "services")
+	runOnMainLoop(func() { app.setSelectView(g, "services") })
 	time.Sleep(1 * time.Second)
-	app.setSelectView(g, "varLogs")
+	runOnMainLoop(func() { app.setSelectView(g, "varLogs") })
 	time.Sleep(1 * time.Second)
-	app.setSelectView(g, "docker")
+	runOnMainLoop(func() { app.setSelectView(g, "docker") })
 	time.Sleep(1 * time.Second)
-	app.setSelectView(g, "filter")
+	runOnMainLoop(func() { app.setSelectView(g, "filter") })
 	time.Sleep(1 * time.Second)
-	app.setSelectView(g, "logs")
+	runOnMainLoop(func() { app.setSelectView(g, "logs") })
 	time.Sleep(1 * time.Second)
 	if debug {
 		textLog := "test mouse"
@@ -1710,29 +3235,48 @@ func TestMockInterface(t *testing.T) {
 	}
 
 	// Переключаем режим фильтрации на timestamp
-	g.SetCurrentView("filter")
-	if v, err := g.View("filter"); err == nil {
-		app.setFilterModeRight(g, v)
+	runOnMainLoop(func() { g.SetCurrentView("filter") })
+	if v, err := viewOnMainLoop("filter"); err == nil {
+		runOnMainLoop(func() { app.setFilterModeRight(g, v) })
 		time.Sleep(1 * time.Second)
-		app.setFilterModeRight(g, v)
+		runOnMainLoop(func() { app.setFilterModeRight(g, v) })
 		time.Sleep(1 * time.Second)
-		app.setFilterModeRight(g, v)
+		runOnMainLoop(func() { app.setFilterModeRight(g, v) })
 		time.Sleep(1 * time.Second)
 	}
 
 	// Проверяем переключение окон в режиме timestamp
-	app.nextView(g, nil)
+	runOnMainLoop(func() { app.nextView(g, nil) })
 	time.Sleep(1 * time.Second)
-	app.nextView(g, nil)
+	runOnMainLoop(func() { app.nextView(g, nil) })
 	time.Sleep(1 * time.Second)
-	app.setSelectView(g, "logs")
+	runOnMainLoop(func() { app.setSelectView(g, "logs") })
 	time.Sleep(1 * time.Second)
-	app.setSelectView(g, "logs")
+	runOnMainLoop(func() { app.setSelectView(g, "logs") })
 	time.Sleep(1 * time.Second)
-	app.setSelectView(g, "logs")
+	runOnMainLoop(func() { app.setSelectView(g, "logs") })
 	time.Sleep(1 * time.Second)
-	app.nextView(g, nil)
+	runOnMainLoop(func() { app.nextView(g, nil) })
 	time.Sleep(1 * time.Second)
 
 	quit(g, nil)
 }
+
+// Функция для выполнения действия в основном потоке интерфейса с ожиданием его завершения
+func runOnMainLoop(f func()) {
+	done := make(chan struct{})
+	g.Update(func(*gocui.Gui) error {
+		f()
+		close(done)
+		return nil
+	})
+	<-done
+}
+
+// Функция для получения окна интерфейса в основном потоке
+func viewOnMainLoop(name string) (v *gocui.View, err error) {
+	runOnMainLoop(func() {
+		v, err = g.View(name)
+	})
+	return v, err
+}